@@ -62,6 +62,7 @@ var ISAddressPrefixCIDR string
 var InstanceName string
 var InstanceProfileName string
 var InstanceProfileNameUpdate string
+var InstanceGPUProfileName string
 var IsBareMetalServerProfileName string
 var IsBareMetalServerImage string
 var DedicatedHostProfileName string
@@ -446,6 +447,12 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable SL_INSTANCE_PROFILE for testing ibm_is_instance resource else it is set to default value 'cx2-2x4'")
 	}
 
+	InstanceGPUProfileName = os.Getenv("IS_INSTANCE_GPU_PROFILE")
+	if InstanceGPUProfileName == "" {
+		InstanceGPUProfileName = "gx2-8x64x1v100"
+		fmt.Println("[INFO] Set the environment variable IS_INSTANCE_GPU_PROFILE for testing ibm_is_instance resource with a GPU profile else it is set to default value 'gx2-8x64x1v100'")
+	}
+
 	InstanceProfileNameUpdate = os.Getenv("SL_INSTANCE_PROFILE_UPDATE")
 	if InstanceProfileNameUpdate == "" {
 		InstanceProfileNameUpdate = "cx2-4x8"
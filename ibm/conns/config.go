@@ -63,6 +63,7 @@ import (
 	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
 	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
 	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+	"github.com/IBM/platform-services-go-sdk/globalcatalogv1"
 	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	iamaccessgroups "github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
 	iamidentity "github.com/IBM/platform-services-go-sdk/iamidentityv1"
@@ -185,6 +186,13 @@ type Config struct {
 	Zone          string
 	Visibility    string
 	EndpointsFile string
+
+	// DefaultTags are attached to every taggable resource in addition to its tags
+	DefaultTags []string
+
+	// IgnoredAttributes are the top-level attributes, per resource type, whose
+	// changes are ignored
+	IgnoredAttributes map[string][]string
 }
 
 //Session stores the information required for communication with the SoftLayer and Bluemix API
@@ -229,6 +237,7 @@ type ClientSession interface {
 	KeyProtectAPI() (*kp.Client, error)
 	KeyManagementAPI() (*kp.Client, error)
 	VpcV1API() (*vpc.VpcV1, error)
+	VpcV1APIForRegion(region string) (*vpc.VpcV1, error)
 	APIGateway() (*apigateway.ApiGatewayControllerApiV1, error)
 	PrivateDNSClientSession() (*dns.DnsSvcsV1, error)
 	CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error)
@@ -266,6 +275,7 @@ type ClientSession interface {
 	IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error)
 	ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error)
 	CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error)
+	GlobalCatalogV1() (*globalcatalogv1.GlobalCatalogV1, error)
 	EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error)
 	ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error)
 	SecretsManagerV1() (*secretsmanagerv1.SecretsManagerV1, error)
@@ -275,6 +285,7 @@ type ClientSession interface {
 	CisFiltersSession() (*cisfiltersv1.FiltersV1, error)
 	CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error)
 	AtrackerV1() (*atrackerv1.AtrackerV1, error)
+	AtrackerV1ForRegion(region string) (*atrackerv1.AtrackerV1, error)
 	ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error)
 	FindingsV1() (*findingsv1.FindingsV1, error)
 	AdminServiceApiV1() (*adminserviceapiv1.AdminServiceApiV1, error)
@@ -282,11 +293,17 @@ type ClientSession interface {
 	PostureManagementV1() (*posturemanagementv1.PostureManagementV1, error)
 	ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error)
 	PostureManagementV2() (*posturemanagementv2.PostureManagementV2, error)
+	DefaultTags() []string
+	IgnoredAttributes(resourceType string) []string
 }
 
 type clientSession struct {
 	session *Session
 
+	defaultTags       []string
+	ignoredAttributes map[string][]string
+	endpointsFile     map[string]interface{}
+
 	appidErr error
 	appidAPI *appid.AppIDManagementV4
 
@@ -506,6 +523,10 @@ type clientSession struct {
 	catalogManagementClient    *catalogmanagementv1.CatalogManagementV1
 	catalogManagementClientErr error
 
+	//Global Catalog Option
+	globalCatalogClient    *globalcatalogv1.GlobalCatalogV1
+	globalCatalogClientErr error
+
 	enterpriseManagementClient    *enterprisemanagementv1.EnterpriseManagementV1
 	enterpriseManagementClientErr error
 
@@ -588,6 +609,10 @@ func (session clientSession) CatalogManagementV1() (*catalogmanagementv1.Catalog
 	return session.catalogManagementClient, session.catalogManagementClientErr
 }
 
+func (session clientSession) GlobalCatalogV1() (*globalcatalogv1.GlobalCatalogV1, error) {
+	return session.globalCatalogClient, session.globalCatalogClientErr
+}
+
 // BluemixAcccountAPI ...
 func (sess clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
 	return sess.bmxAccountServiceAPI, sess.accountConfigErr
@@ -608,6 +633,17 @@ func (sess clientSession) BluemixUserDetails() (*UserConfig, error) {
 	return sess.bmxUserDetails, sess.bmxUserFetchErr
 }
 
+// DefaultTags returns the default_tags of the provider
+func (sess clientSession) DefaultTags() []string {
+	return sess.defaultTags
+}
+
+// IgnoredAttributes returns the attributes of resourceType whose changes the
+// ignore_changes rules of the provider ignore
+func (sess clientSession) IgnoredAttributes(resourceType string) []string {
+	return sess.ignoredAttributes[resourceType]
+}
+
 // ContainerAPI provides Container Service APIs ...
 func (sess clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
 	return sess.csServiceAPI, sess.csConfigErr
@@ -771,6 +807,33 @@ func (sess clientSession) VpcV1API() (*vpc.VpcV1, error) {
 	return sess.vpcAPI, sess.vpcErr
 }
 
+// VpcV1APIForRegion returns a VPC client scoped to the given region, for the
+// resources that reference VPC objects of other regions through their CRN.
+// An empty region or the provider region returns the provider level client.
+func (sess clientSession) VpcV1APIForRegion(region string) (*vpc.VpcV1, error) {
+	if region == "" || sess.session.BluemixSession == nil || sess.vpcAPI == nil || region == sess.session.BluemixSession.Config.Region {
+		return sess.vpcAPI, sess.vpcErr
+	}
+	if EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, "") != "" {
+		return nil, fmt.Errorf("[ERROR] The region %q cannot be used when the VPC endpoint is overridden with IBMCLOUD_IS_NG_API_ENDPOINT", region)
+	}
+
+	visibility := sess.session.BluemixSession.Config.Visibility
+	vpcurl := ContructEndpoint(fmt.Sprintf("%s.iaas", region), fmt.Sprintf("%s/v1", cloudEndpoint))
+	if visibility == "private" || visibility == "public-and-private" {
+		vpcurl = ContructEndpoint(fmt.Sprintf("%s.private.iaas", region), fmt.Sprintf("%s/v1", cloudEndpoint))
+	}
+	if sess.endpointsFile != nil && visibility != "public-and-private" {
+		vpcurl = fileFallBack(sess.endpointsFile, visibility, "IBMCLOUD_IS_NG_API_ENDPOINT", region, vpcurl)
+	}
+
+	vpcclient := sess.vpcAPI.Clone()
+	if err := vpcclient.SetServiceURL(vpcurl); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error occured while configuring vpc service for region %q: %q", region, err)
+	}
+	return vpcclient, nil
+}
+
 func (sess clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
 	return sess.directlinkAPI, sess.directlinkErr
 }
@@ -1060,6 +1123,39 @@ func (session clientSession) AtrackerV1() (*atrackerv1.AtrackerV1, error) {
 	return session.atrackerClient, session.atrackerClientErr
 }
 
+// AtrackerV1ForRegion returns an Activity Tracker client scoped to the given region,
+// so that a single provider block can manage targets and routes in several regions.
+// An empty region or the provider region returns the provider level client.
+func (session clientSession) AtrackerV1ForRegion(region string) (*atrackerv1.AtrackerV1, error) {
+	if region == "" || session.session.BluemixSession == nil || session.atrackerClient == nil || region == session.session.BluemixSession.Config.Region {
+		return session.atrackerClient, session.atrackerClientErr
+	}
+	if EnvFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, "") != "" {
+		return nil, fmt.Errorf("[ERROR] The region %q cannot be used when the Activity Tracker endpoint is overridden with IBMCLOUD_ATRACKER_API_ENDPOINT", region)
+	}
+
+	visibility := session.session.BluemixSession.Config.Visibility
+	atrackerClientURL, err := atrackerv1.GetServiceURLForRegion(region)
+	if visibility == "private" || visibility == "public-and-private" {
+		privateURL, privateErr := atrackerv1.GetServiceURLForRegion("private." + region)
+		if privateErr == nil || visibility == "private" {
+			atrackerClientURL, err = privateURL, privateErr
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error occurred while configuring Activity Tracker API service for region %q: %q", region, err)
+	}
+	if session.endpointsFile != nil && visibility != "public-and-private" {
+		atrackerClientURL = fileFallBack(session.endpointsFile, visibility, "IBMCLOUD_ATRACKER_API_ENDPOINT", region, atrackerClientURL)
+	}
+
+	atrackerClient := session.atrackerClient.Clone()
+	if err = atrackerClient.SetServiceURL(atrackerClientURL); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error occurred while configuring Activity Tracker API service for region %q: %q", region, err)
+	}
+	return atrackerClient, nil
+}
+
 func (session clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
 	return session.esSchemaRegistryClient, session.esSchemaRegistryErr
 }
@@ -1110,7 +1206,9 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	log.Printf("[INFO] Configured Region: %s\n", c.Region)
 	session := clientSession{
-		session: sess,
+		session:           sess,
+		defaultTags:       c.DefaultTags,
+		ignoredAttributes: c.IgnoredAttributes,
 	}
 
 	if sess.BluemixSession == nil {
@@ -1143,6 +1241,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.enterpriseManagementClientErr = errEmptyBluemixCredentials
 		session.resourceControllerErr = errEmptyBluemixCredentials
 		session.catalogManagementClientErr = errEmptyBluemixCredentials
+		session.globalCatalogClientErr = errEmptyBluemixCredentials
 		session.ibmpiConfigErr = errEmptyBluemixCredentials
 		session.userManagementErr = errEmptyBluemixCredentials
 		session.certManagementErr = errEmptyBluemixCredentials
@@ -1275,6 +1374,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			log.Fatalf("Unable to unmarshal Endpoints File %s", err)
 		}
 	}
+	session.endpointsFile = fileMap
 	accv1API, err := accountv1.New(sess.BluemixSession)
 	if err != nil {
 		session.accountV1ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Bluemix Accountv1 Service: %q", err)
@@ -1431,6 +1531,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if appIDClient != nil && appIDClient.Service != nil {
 		appIDClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(appIDClient.Service)
 		appIDClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -1455,6 +1556,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err == nil && session.contextBasedRestrictionsClient != nil {
 		// Enable retries for API calls
 		session.contextBasedRestrictionsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.contextBasedRestrictionsClient.Service)
 		// Add custom header for analytics
 		session.contextBasedRestrictionsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1483,12 +1585,41 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if session.catalogManagementClient != nil && session.catalogManagementClient.Service != nil {
 		// Enable retries for API calls
 		session.catalogManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.catalogManagementClient.Service)
 		// Add custom header for analytics
 		session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 
+	// GLOBAL CATALOG Service
+	globalCatalogURL := globalcatalogv1.DefaultServiceURL
+	if c.Visibility == "private" {
+		session.globalCatalogClientErr = fmt.Errorf("Global Catalog resource doesnot support private endpoints")
+	}
+	if fileMap != nil && c.Visibility != "public-and-private" {
+		globalCatalogURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GLOBAL_CATALOG_API_ENDPOINT", c.Region, globalCatalogURL)
+	}
+	globalCatalogClientOptions := &globalcatalogv1.GlobalCatalogV1Options{
+		URL:           EnvFallBack([]string{"IBMCLOUD_GLOBAL_CATALOG_API_ENDPOINT"}, globalCatalogURL),
+		Authenticator: authenticator,
+	}
+	// Construct the service client.
+	globalCatalogClient, err := globalcatalogv1.NewGlobalCatalogV1(globalCatalogClientOptions)
+	if err != nil {
+		session.globalCatalogClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Global Catalog API service: %q", err)
+	}
+	if globalCatalogClient != nil && globalCatalogClient.Service != nil && session.globalCatalogClientErr == nil {
+		session.globalCatalogClient = globalCatalogClient
+		// Enable retries for API calls
+		session.globalCatalogClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.globalCatalogClient.Service)
+		// Add custom header for analytics
+		session.globalCatalogClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	}
+
 	// ATRACKER Service
 	var atrackerClientURL string
 	atrackerClientURL, err = atrackerv1.GetServiceURLForRegion(c.Region)
@@ -1519,6 +1650,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if session.atrackerClient != nil && session.atrackerClient.Service != nil {
 		// Enable retries for API calls
 		session.atrackerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.atrackerClient.Service)
 		// Add custom header for analytics
 		session.atrackerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1551,6 +1683,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if session.findingsClient != nil && session.findingsClient.Service != nil {
 		// Enable retries for API calls
 		session.findingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.findingsClient.Service)
 		// Add custom header for analytics
 		session.findingsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1580,6 +1713,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err == nil {
 		// Enable retries for API calls
 		session.adminServiceApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.adminServiceApiClient.Service)
 		// Add custom header for analytics
 		session.adminServiceApiClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1614,6 +1748,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	// Enable retries for API calls
 	if schematicsClient != nil && schematicsClient.Service != nil {
 		schematicsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(schematicsClient.Service)
 		schematicsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -1638,6 +1773,9 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if vpcclient != nil && vpcclient.Service != nil {
 		vpcclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(vpcclient.Service)
+		// Serve repeated list calls of the VPC data sources from memory, see WithListCache
+		vpcclient.Service.Client.Transport = NewListCache(vpcclient.Service.Client.Transport)
 		vpcclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -1663,6 +1801,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if pnclient != nil && pnclient.Service != nil {
 		// Enable retries for API calls
 		pnclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(pnclient.Service)
 		pnclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -1689,6 +1828,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if session.eventNotificationsApiClient != nil && session.eventNotificationsApiClient.Service != nil {
 		// Enable retries for API calls
 		session.eventNotificationsApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.eventNotificationsApiClient.Service)
 		session.eventNotificationsApiClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -1705,6 +1845,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if appConfigClient != nil {
 		// Enable retries for API calls
 		appConfigClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(appConfigClient.Service)
 		session.appConfigurationClient = appConfigClient
 	} else {
 		session.appConfigurationClientErr = fmt.Errorf("[ERROR] Error occurred while configuring App Configuration service: %q", err)
@@ -1738,6 +1879,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if session.containerRegistryClient != nil && session.containerRegistryClient.Service != nil {
 		// Enable retries for API calls
 		session.containerRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.containerRegistryClient.Service)
 		// Add custom header for analytics
 		session.containerRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1796,6 +1938,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if globalTaggingAPIV1 != nil && globalTaggingAPIV1.Service != nil {
 		session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
 		session.globalTaggingServiceAPIV1.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.globalTaggingServiceAPIV1.Service)
 		session.globalTaggingServiceAPIV1.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -1826,6 +1969,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err == nil {
 		// Enable retries for API calls
 		session.cloudDatabasesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cloudDatabasesClient.Service)
 		// Add custom header for analytics
 		session.cloudDatabasesClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1928,6 +2072,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.pDNSClient != nil && session.pDNSClient.Service != nil {
 		session.pDNSClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.pDNSClient.Service)
 		session.pDNSClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -1953,6 +2098,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
 		session.directlinkAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.directlinkAPI.Service)
 		session.directlinkAPI.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -1977,6 +2123,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
 		session.dlProviderAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.dlProviderAPI.Service)
 		session.dlProviderAPI.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2001,6 +2148,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
 		session.transitgatewayAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.transitgatewayAPI.Service)
 		// session.transitgatewayAPI.SetDefaultHeaders(gohttp.Header{
 		// 	"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		// })
@@ -2054,6 +2202,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
 		session.cisZonesV1Client.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisZonesV1Client.Service)
 		session.cisZonesV1Client.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2072,6 +2221,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
 		session.cisDNSRecordsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisDNSRecordsClient.Service)
 		session.cisDNSRecordsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2092,6 +2242,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
 		session.cisDNSRecordBulkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisDNSRecordBulkClient.Service)
 		session.cisDNSRecordBulkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2112,6 +2263,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
 		session.cisGLBPoolClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisGLBPoolClient.Service)
 		session.cisGLBPoolClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2132,6 +2284,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
 		session.cisGLBClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisGLBClient.Service)
 		session.cisGLBClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2152,6 +2305,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
 		session.cisGLBHealthCheckClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisGLBHealthCheckClient.Service)
 		session.cisGLBHealthCheckClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2169,6 +2323,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisIPClient != nil && session.cisIPClient.Service != nil {
 		session.cisIPClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisIPClient.Service)
 		session.cisIPClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2189,6 +2344,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisRLClient != nil && session.cisRLClient.Service != nil {
 		session.cisRLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisRLClient.Service)
 		session.cisRLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2207,6 +2363,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisAlertsClient != nil && session.cisAlertsClient.Service != nil {
 		session.cisAlertsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisAlertsClient.Service)
 		session.cisAlertsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2227,6 +2384,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
 		session.cisPageRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisPageRuleClient.Service)
 		session.cisPageRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2248,6 +2406,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
 		session.cisEdgeFunctionClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisEdgeFunctionClient.Service)
 		session.cisEdgeFunctionClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2269,6 +2428,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
 		session.cisSSLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisSSLClient.Service)
 		session.cisSSLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2290,6 +2450,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
 		session.cisWAFPackageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisWAFPackageClient.Service)
 		session.cisWAFPackageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2311,6 +2472,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
 		session.cisDomainSettingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisDomainSettingsClient.Service)
 		session.cisDomainSettingsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2332,6 +2494,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
 		session.cisRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisRoutingClient.Service)
 		session.cisRoutingClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2353,6 +2516,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
 		session.cisWAFGroupClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisWAFGroupClient.Service)
 		session.cisWAFGroupClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2374,6 +2538,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
 		session.cisCacheClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisCacheClient.Service)
 		session.cisCacheClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2396,6 +2561,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
 		session.cisCustomPageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisCustomPageClient.Service)
 		session.cisCustomPageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2417,6 +2583,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
 		session.cisAccessRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisAccessRuleClient.Service)
 		session.cisAccessRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2438,6 +2605,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
 		session.cisUARuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisUARuleClient.Service)
 		session.cisUARuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2459,6 +2627,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
 		session.cisLockdownClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisLockdownClient.Service)
 		session.cisLockdownClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2480,6 +2649,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
 		session.cisRangeAppClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisRangeAppClient.Service)
 		session.cisRangeAppClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2501,6 +2671,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
 		session.cisWAFRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisWAFRuleClient.Service)
 		session.cisWAFRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2522,6 +2693,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisLogpushJobsClient != nil && session.cisLogpushJobsClient.Service != nil {
 		session.cisLogpushJobsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisLogpushJobsClient.Service)
 		session.cisLogpushJobsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2541,6 +2713,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisWebhooksClient != nil && session.cisWebhooksClient.Service != nil {
 		session.cisWebhooksClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisWebhooksClient.Service)
 		session.cisWebhooksClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2558,6 +2731,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
 		session.cisFiltersClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisFiltersClient.Service)
 		session.cisFiltersClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2576,6 +2750,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisFirewallRulesClient != nil && session.cisFirewallRulesClient.Service != nil {
 		session.cisFirewallRulesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.cisFirewallRulesClient.Service)
 		session.cisFirewallRulesClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2604,6 +2779,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if iamIdentityClient != nil && iamIdentityClient.Service != nil {
 		iamIdentityClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(iamIdentityClient.Service)
 		iamIdentityClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2632,6 +2808,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
 		iamPolicyManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(iamPolicyManagementClient.Service)
 		iamPolicyManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2660,6 +2837,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if iamAccessGroupsClient != nil && iamAccessGroupsClient.Service != nil {
 		iamAccessGroupsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(iamAccessGroupsClient.Service)
 		iamAccessGroupsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2696,6 +2874,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if resourceManagerClient != nil && resourceManagerClient.Service != nil {
 		resourceManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(resourceManagerClient.Service)
 		resourceManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2717,6 +2896,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.ibmCloudShellClient != nil && session.ibmCloudShellClient.Service != nil {
 		session.ibmCloudShellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.ibmCloudShellClient.Service)
 		session.ibmCloudShellClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2753,6 +2933,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if enterpriseManagementClient != nil && enterpriseManagementClient.Service != nil {
 		enterpriseManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(enterpriseManagementClient.Service)
 		enterpriseManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2789,6 +2970,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if resourceControllerClient != nil && resourceControllerClient.Service != nil {
 		resourceControllerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(resourceControllerClient.Service)
 		resourceControllerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2807,6 +2989,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if session.secretsManagerClient != nil && session.secretsManagerClient.Service != nil {
 		// Enable retries for API calls
 		session.secretsManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.secretsManagerClient.Service)
 		// Add custom header for analytics
 		session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2833,6 +3016,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	// Enable retries for API calls
 	if session.satelliteClient != nil && session.satelliteClient.Service != nil {
 		session.satelliteClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.satelliteClient.Service)
 		session.satelliteClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2858,6 +3042,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if session.satelliteLinkClient != nil && session.satelliteLinkClient.Service != nil {
 		// Enable retries for API calls
 		session.satelliteLinkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.satelliteLinkClient.Service)
 		// Add custom header for analytics
 		session.satelliteLinkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2873,6 +3058,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.esSchemaRegistryClient != nil && session.esSchemaRegistryClient.Service != nil {
 		session.esSchemaRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.esSchemaRegistryClient.Service)
 		session.esSchemaRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2899,6 +3085,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err == nil {
 		// Enable retries for API calls
 		session.configServiceApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.configServiceApiClient.Service)
 		// Add custom header for analytics
 		session.configServiceApiClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2935,6 +3122,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if session.postureManagementClient != nil && session.postureManagementClient.Service != nil {
 		// Enable retries for API calls
 		session.postureManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.postureManagementClient.Service)
 		// Add custom header for analytics
 		session.postureManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2968,6 +3156,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if session.postureManagementClientv2 != nil && session.postureManagementClientv2.Service != nil {
 		// Enable retries for API calls
 		session.postureManagementClientv2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		InstrumentTelemetry(session.postureManagementClientv2.Service)
 		// Add custom header for analytics
 		session.postureManagementClientv2.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
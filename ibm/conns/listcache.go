@@ -0,0 +1,144 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"log"
+	gohttp "net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ListCacheTTL is how long a cached collection is served before it is fetched
+// again.
+const ListCacheTTL = 30 * time.Second
+
+// ListCache is a http.RoundTripper that serves repeated GET requests of
+// collection endpoints, such as /v1/subnets or /v1/vpcs, from memory, so that
+// several data sources listing the same collection within one Terraform
+// operation only fetch it once. Only the requests made with a context returned
+// by WithListCache are served from or stored in the cache, and a cached
+// response expires after ListCacheTTL. Any request other than a GET discards
+// the cached responses, so that a list read after a create or delete is
+// fetched again.
+type ListCache struct {
+	next gohttp.RoundTripper
+	ttl  time.Duration
+	now  func() time.Time
+
+	lock       sync.Mutex
+	store      map[string]*listCacheEntry
+	generation int
+}
+
+type listCacheEntry struct {
+	statusCode int
+	header     gohttp.Header
+	body       []byte
+	expires    time.Time
+}
+
+type listCacheKey struct{}
+
+// WithListCache returns a context whose collection list calls may be served by
+// a ListCache. It is meant for the reads of data sources, resources must not
+// use it so that they always see the current state of a collection.
+func WithListCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, listCacheKey{}, true)
+}
+
+func listCacheEnabled(ctx context.Context) bool {
+	enabled, _ := ctx.Value(listCacheKey{}).(bool)
+	return enabled
+}
+
+// NewListCache returns a ListCache that sends the requests it does not serve
+// from memory to next.
+func NewListCache(next gohttp.RoundTripper) *ListCache {
+	if next == nil {
+		next = gohttp.DefaultTransport
+	}
+	return &ListCache{
+		next:  next,
+		ttl:   ListCacheTTL,
+		now:   time.Now,
+		store: make(map[string]*listCacheEntry),
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (c *ListCache) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	if req.Method != gohttp.MethodGet {
+		c.lock.Lock()
+		c.store = make(map[string]*listCacheEntry)
+		c.generation++
+		c.lock.Unlock()
+		return c.next.RoundTrip(req)
+	}
+	if !listCacheEnabled(req.Context()) || !isCollectionPath(req.URL.Path) {
+		return c.next.RoundTrip(req)
+	}
+
+	key := req.URL.String()
+	c.lock.Lock()
+	entry, ok := c.store[key]
+	if ok && !c.now().Before(entry.expires) {
+		delete(c.store, key)
+		ok = false
+	}
+	generation := c.generation
+	c.lock.Unlock()
+	if ok {
+		log.Printf("[DEBUG] Serving %s from the list cache", req.URL.Path)
+		return entry.response(req), nil
+	}
+
+	resp, err := c.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != gohttp.StatusOK {
+		return resp, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	entry = &listCacheEntry{
+		statusCode: resp.StatusCode,
+		header:     resp.Header.Clone(),
+		body:       body,
+		expires:    c.now().Add(c.ttl),
+	}
+	c.lock.Lock()
+	// Do not keep a list that was fetched while another request changed it
+	if generation == c.generation {
+		c.store[key] = entry
+	}
+	c.lock.Unlock()
+	return entry.response(req), nil
+}
+
+func (e *listCacheEntry) response(req *gohttp.Request) *gohttp.Response {
+	return &gohttp.Response{
+		Status:        gohttp.StatusText(e.statusCode),
+		StatusCode:    e.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
+
+// isCollectionPath reports whether path addresses a top level collection of a
+// versioned API, for example /v1/subnets, rather than a single resource.
+func isCollectionPath(path string) bool {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	return len(segments) == 2 && strings.HasPrefix(segments[0], "v")
+}
@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	gohttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestListCache(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		calls++
+		fmt.Fprintf(w, "%s %d", r.URL.Path, calls)
+	}))
+	defer server.Close()
+
+	now := time.Now()
+	cache := NewListCache(gohttp.DefaultTransport)
+	cache.now = func() time.Time { return now }
+	client := &gohttp.Client{Transport: cache}
+	get := func(ctx context.Context, path string) string {
+		req, err := gohttp.NewRequestWithContext(ctx, gohttp.MethodGet, server.URL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(body)
+	}
+	cached := WithListCache(context.Background())
+
+	if got := get(cached, "/v1/subnets?version=1"); got != "/v1/subnets 1" {
+		t.Fatalf("unexpected first response %q", got)
+	}
+	if got := get(cached, "/v1/subnets?version=1"); got != "/v1/subnets 1" {
+		t.Fatalf("expected a cached response, got %q", got)
+	}
+	if got := get(context.Background(), "/v1/subnets?version=1"); got != "/v1/subnets 2" {
+		t.Fatalf("expected requests without the list cache context not to be cached, got %q", got)
+	}
+	if got := get(cached, "/v1/subnets/abc?version=1"); got != "/v1/subnets/abc 3" {
+		t.Fatalf("expected single resources not to be cached, got %q", got)
+	}
+
+	now = now.Add(ListCacheTTL)
+	if got := get(cached, "/v1/subnets?version=1"); got != "/v1/subnets 4" {
+		t.Fatalf("expected the cached response to expire, got %q", got)
+	}
+
+	resp, err := client.Post(server.URL+"/v1/subnets", "application/json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if got := get(cached, "/v1/subnets?version=1"); got != "/v1/subnets 6" {
+		t.Fatalf("expected the cache to be discarded after a POST, got %q", got)
+	}
+}
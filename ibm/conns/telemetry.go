@@ -0,0 +1,214 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	gohttp "net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// TelemetryProviderScope is the name under which the API calls that cannot be
+// attributed to a resource or data source are recorded: the calls made to
+// configure the provider, and the calls of the resources and data sources that
+// do not pass the context of a context aware operation to the SDK. The legacy
+// Create, Read, Update and Delete functions have no context, so only their
+// operations are recorded per resource, not their API calls.
+const TelemetryProviderScope = "provider"
+
+// Telemetry records the operations of the resources and data sources, and the
+// API calls and retries they make, and writes the totals as JSON to a file.
+// Terraform does not tell a provider that a plan or apply ends, so the file is
+// rewritten after each operation and holds the totals of the run when it ends.
+type Telemetry struct {
+	path string
+
+	lock      sync.Mutex
+	resources map[string]*telemetryResource
+}
+
+type telemetryResource struct {
+	Operations    map[string]*telemetryOperation `json:"operations,omitempty"`
+	APICalls      int                            `json:"api_calls"`
+	APIErrors     int                            `json:"api_errors"`
+	APIDurationMs int64                          `json:"api_duration_ms"`
+	Retries       int                            `json:"retries"`
+}
+
+type telemetryOperation struct {
+	Count      int   `json:"count"`
+	Errors     int   `json:"errors"`
+	DurationMs int64 `json:"duration_ms"`
+}
+
+type telemetryScopeKey struct{}
+
+var (
+	telemetryOnce sync.Once
+	telemetryLock sync.RWMutex
+	telemetry     *Telemetry
+)
+
+// EnableTelemetry starts recording the telemetry of this provider run to the
+// file at path. Only the first call has an effect, so that aliased providers
+// share one file.
+func EnableTelemetry(path string) {
+	telemetryOnce.Do(func() {
+		telemetryLock.Lock()
+		telemetry = &Telemetry{
+			path:      path,
+			resources: make(map[string]*telemetryResource),
+		}
+		telemetryLock.Unlock()
+		log.Printf("[INFO] Writing provider telemetry to %s", path)
+	})
+}
+
+// currentTelemetry returns the telemetry of this provider run, nil if it is not
+// enabled.
+func currentTelemetry() *Telemetry {
+	telemetryLock.RLock()
+	defer telemetryLock.RUnlock()
+	return telemetry
+}
+
+// TelemetryEnabled reports whether EnableTelemetry was called.
+func TelemetryEnabled() bool {
+	return currentTelemetry() != nil
+}
+
+// WithTelemetryScope returns a context that attributes the API calls made with
+// it to the resource or data source name.
+func WithTelemetryScope(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, telemetryScopeKey{}, name)
+}
+
+func telemetryScope(ctx context.Context) string {
+	if name, ok := ctx.Value(telemetryScopeKey{}).(string); ok {
+		return name
+	}
+	return TelemetryProviderScope
+}
+
+// RecordTelemetryOperation records an operation, such as create or read, of
+// the resource or data source name that started at start, and writes the
+// telemetry file.
+func RecordTelemetryOperation(name, operation string, start time.Time, failed bool) {
+	telemetry := currentTelemetry()
+	if telemetry == nil {
+		return
+	}
+	telemetry.lock.Lock()
+	r := telemetry.resource(name)
+	if r.Operations == nil {
+		r.Operations = make(map[string]*telemetryOperation)
+	}
+	op, ok := r.Operations[operation]
+	if !ok {
+		op = &telemetryOperation{}
+		r.Operations[operation] = op
+	}
+	op.Count++
+	if failed {
+		op.Errors++
+	}
+	op.DurationMs += time.Since(start).Milliseconds()
+	telemetry.lock.Unlock()
+	telemetry.write()
+}
+
+// resource must be called with the lock held.
+func (t *Telemetry) resource(name string) *telemetryResource {
+	r, ok := t.resources[name]
+	if !ok {
+		r = &telemetryResource{}
+		t.resources[name] = r
+	}
+	return r
+}
+
+// write replaces the telemetry file, through a temporary file so that it is
+// never read half written.
+func (t *Telemetry) write() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	data, err := json.MarshalIndent(map[string]interface{}{"resources": t.resources}, "", "  ")
+	if err != nil {
+		log.Printf("[WARN] Error encoding provider telemetry: %s", err)
+		return
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(t.path), ".telemetry-*.json")
+	if err != nil {
+		log.Printf("[WARN] Error writing provider telemetry: %s", err)
+		return
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), t.path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		log.Printf("[WARN] Error writing provider telemetry: %s", err)
+	}
+}
+
+// InstrumentTelemetry records the API calls of service, it must be called after
+// retries are enabled on the service. Nothing is recorded if telemetry is not
+// enabled. The calls are attributed to the resource or data source whose
+// context they are made with, see WithTelemetryScope, and to
+// TelemetryProviderScope otherwise.
+func InstrumentTelemetry(service *core.BaseService) {
+	telemetry := currentTelemetry()
+	if telemetry == nil || service == nil || service.Client == nil {
+		return
+	}
+	if rt, ok := service.Client.Transport.(*retryablehttp.RoundTripper); ok && rt.Client != nil {
+		rt.Client.RequestLogHook = func(_ retryablehttp.Logger, req *gohttp.Request, attempt int) {
+			if attempt == 0 {
+				return
+			}
+			telemetry.lock.Lock()
+			telemetry.resource(telemetryScope(req.Context())).Retries++
+			telemetry.lock.Unlock()
+		}
+	}
+	service.Client.Transport = &telemetryTransport{next: service.Client.Transport, telemetry: telemetry}
+}
+
+type telemetryTransport struct {
+	next      gohttp.RoundTripper
+	telemetry *Telemetry
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *telemetryTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	next := t.next
+	if next == nil {
+		next = gohttp.DefaultTransport
+	}
+	start := time.Now()
+	resp, err := next.RoundTrip(req)
+
+	t.telemetry.lock.Lock()
+	defer t.telemetry.lock.Unlock()
+	r := t.telemetry.resource(telemetryScope(req.Context()))
+	r.APICalls++
+	r.APIDurationMs += time.Since(start).Milliseconds()
+	if err != nil || resp.StatusCode >= 400 {
+		r.APIErrors++
+	}
+	return resp, err
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	gohttp "net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestTelemetry(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		calls++
+		// Fail the first call once, so that it is retried
+		if calls == 1 {
+			w.WriteHeader(gohttp.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(gohttp.StatusOK)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "telemetry.json")
+	EnableTelemetry(path)
+
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	service.EnableRetries(2, 10*time.Millisecond)
+	InstrumentTelemetry(service)
+
+	ctx := WithTelemetryScope(context.Background(), "ibm_is_vpc")
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		req, err := gohttp.NewRequestWithContext(ctx, gohttp.MethodGet, server.URL+"/v1/vpcs", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := service.Client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	RecordTelemetryOperation("ibm_is_vpc", "read", start, false)
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var result struct {
+		Resources map[string]telemetryResource `json:"resources"`
+	}
+	if err = json.Unmarshal(data, &result); err != nil {
+		t.Fatal(err)
+	}
+	vpc, ok := result.Resources["ibm_is_vpc"]
+	if !ok {
+		t.Fatalf("no telemetry recorded for ibm_is_vpc: %s", data)
+	}
+	if vpc.APICalls != 2 || vpc.Retries != 1 || vpc.APIErrors != 0 {
+		t.Fatalf("expected 2 API calls with 1 retry and no errors, got %+v", vpc)
+	}
+	if op := vpc.Operations["read"]; op == nil || op.Count != 1 || op.Errors != 0 {
+		t.Fatalf("expected 1 read operation, got %+v", vpc.Operations)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TrackCreatedID stores the ID of a remote object in state as soon as the
+// create call has returned, before any waiter runs. If the create fails after
+// this point, Terraform keeps the object in state and marks it as tainted
+// instead of leaving it behind outside of state.
+func TrackCreatedID(d *schema.ResourceData, id string) {
+	d.SetId(id)
+	log.Printf("[INFO] Tracking created object (%s) in state while waiting for it to become ready", id)
+}
+
+// TrackedCreateError converts an error raised while waiting for a tracked
+// object (see TrackCreatedID) into an error that tells the user the object
+// still exists and how it is handled on the next apply.
+func TrackedCreateError(d *schema.ResourceData, resourceName string, err error) error {
+	diags := TrackedCreateDiagnostics(d, resourceName, err)
+	return fmt.Errorf("%s: %s", diags[0].Summary, diags[0].Detail)
+}
+
+// TrackedCreateDiagnostics is the diag.Diagnostics counterpart of
+// TrackedCreateError for resources using the context aware CRUD functions.
+func TrackedCreateDiagnostics(d *schema.ResourceData, resourceName string, err error) diag.Diagnostics {
+	// The summary carries the [ERROR] prefix, do not repeat it in the detail
+	detail := strings.TrimPrefix(err.Error(), "[ERROR] ")
+	var timeoutErr *resource.TimeoutError
+	if errors.As(err, &timeoutErr) {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("[ERROR] Timed out waiting for %s (%s) to be created", resourceName, d.Id()),
+				Detail: fmt.Sprintf("%s. The %s was created and is tracked in the Terraform state, marked as tainted. "+
+					"Run 'terraform untaint' and apply again with a longer create timeout to keep it, "+
+					"or apply again to replace it.", detail, resourceName),
+			},
+		}
+	}
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("[ERROR] Error waiting for %s (%s) to be created", resourceName, d.Id()),
+			Detail: fmt.Sprintf("%s. The %s is tracked in the Terraform state, marked as tainted, "+
+				"and is replaced on the next apply.", detail, resourceName),
+		},
+	}
+}
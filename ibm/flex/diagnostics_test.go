@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestTrackedCreateError(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"name": {Type: schema.TypeString, Optional: true},
+	}, map[string]interface{}{})
+	TrackCreatedID(d, "r006-1234")
+	if d.Id() != "r006-1234" {
+		t.Fatalf("expected the created ID to be in state, got %q", d.Id())
+	}
+
+	err := TrackedCreateError(d, "instance", errors.New("[ERROR] Error getting instance: 500 Internal Server Error"))
+	msg := err.Error()
+	if !strings.HasPrefix(msg, "[ERROR] Error waiting for instance (r006-1234) to be created: ") {
+		t.Errorf("unexpected message %q", msg)
+	}
+	if strings.Count(msg, "[ERROR]") != 1 {
+		t.Errorf("expected a single [ERROR] prefix, got %q", msg)
+	}
+	if !strings.Contains(msg, "is replaced on the next apply") {
+		t.Errorf("expected the message to explain the tainted object, got %q", msg)
+	}
+
+	timeoutErr := &resource.TimeoutError{LastError: errors.New("pending"), ExpectedState: []string{"running"}}
+	diags := TrackedCreateDiagnostics(d, "instance", timeoutErr)
+	if len(diags) != 1 || !diags.HasError() {
+		t.Fatalf("expected one error diagnostic, got %v", diags)
+	}
+	if !strings.HasPrefix(diags[0].Summary, "[ERROR] Timed out waiting for instance (r006-1234)") {
+		t.Errorf("unexpected timeout summary %q", diags[0].Summary)
+	}
+	if !strings.Contains(diags[0].Detail, "terraform untaint") {
+		t.Errorf("expected the timeout detail to suggest terraform untaint, got %q", diags[0].Detail)
+	}
+}
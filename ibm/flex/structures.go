@@ -2012,11 +2012,9 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 	}
 
 	if strings.TrimSpace(tagType) == "" || tagType == "user" {
-		schematicTags := os.Getenv("IC_ENV_TAGS")
-		var envTags []string
-		if schematicTags != "" {
-			envTags = strings.Split(schematicTags, ",")
+		if envTags := EnvTags(meta); len(envTags) > 0 {
 			add = append(add, envTags...)
+			remove = withoutEnvTags(remove, envTags)
 		}
 	}
 
@@ -2066,6 +2064,36 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 	return nil
 }
 
+// EnvTags returns the tags that are attached to every taggable resource: the
+// environment tags supplied by Schematics in IC_ENV_TAGS and the default_tags of
+// the provider that meta belongs to.
+func EnvTags(meta interface{}) []string {
+	var tags []string
+	if schematicTags := os.Getenv("IC_ENV_TAGS"); schematicTags != "" {
+		tags = strings.Split(schematicTags, ",")
+	}
+	if sess, ok := meta.(conns.ClientSession); ok {
+		tags = append(tags, sess.DefaultTags()...)
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	return ExpandStringList(NewStringSet(ResourceIBMVPCHash, tags).List())
+}
+
+// withoutEnvTags drops the environment and provider default tags from the tags to
+// detach, since those stay attached as long as they are configured.
+func withoutEnvTags(remove, envTags []string) []string {
+	envSet := NewStringSet(ResourceIBMVPCHash, envTags)
+	tags := make([]string, 0, len(remove))
+	for _, tag := range remove {
+		if !envSet.Contains(tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
 func ResourceIBMVPCHash(v interface{}) int {
 	var buf bytes.Buffer
 	buf.WriteString(fmt.Sprintf("%s",
@@ -2122,11 +2150,9 @@ func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resource
 		remove[i] = fmt.Sprint(v)
 	}
 
-	schematicTags := os.Getenv("IC_ENV_TAGS")
-	var envTags []string
-	if schematicTags != "" {
-		envTags = strings.Split(schematicTags, ",")
+	if envTags := EnvTags(meta); len(envTags) > 0 {
 		add = append(add, envTags...)
+		remove = withoutEnvTags(remove, envTags)
 	}
 
 	if len(remove) > 0 {
@@ -2171,7 +2197,7 @@ func FlattenSSLCiphers(ciphers []datatypes.Network_LBaaS_SSLCipher) *schema.Set
 	return NewStringSet(schema.HashString, c)
 }
 
-func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff) error {
+func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
 
 	if diff.Id() != "" && diff.HasChange("tags") {
 		o, n := diff.GetChange("tags")
@@ -2179,17 +2205,29 @@ func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff) error {
 		newSet := n.(*schema.Set)
 		removeInt := oldSet.Difference(newSet).List()
 		addInt := newSet.Difference(oldSet).List()
-		if v := os.Getenv("IC_ENV_TAGS"); v != "" {
-			s := strings.Split(v, ",")
-			if len(removeInt) == len(s) && len(addInt) == 0 {
-				fmt.Println("Suppresing the TAG diff ")
-				return diff.Clear("tags")
-			}
+		if v := EnvTags(meta); len(v) > 0 && onlyEnvTagsRemoved(removeInt, addInt, v) {
+			fmt.Println("Suppresing the TAG diff ")
+			return diff.Clear("tags")
 		}
 	}
 	return nil
 }
 
+// onlyEnvTagsRemoved reports whether a tags diff only removes environment or
+// default tags, which stay attached and must not show up as a change.
+func onlyEnvTagsRemoved(removeInt, addInt []interface{}, envTags []string) bool {
+	if len(addInt) > 0 {
+		return false
+	}
+	envSet := NewStringSet(ResourceIBMVPCHash, envTags)
+	for _, tag := range removeInt {
+		if !envSet.Contains(tag) {
+			return false
+		}
+	}
+	return true
+}
+
 func ResourceLBListenerPolicyCustomizeDiff(diff *schema.ResourceDiff) error {
 	policyActionIntf, _ := diff.GetOk(isLBListenerPolicyAction)
 	policyAction := policyActionIntf.(string)
@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"os"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+type defaultTagsSession struct {
+	conns.ClientSession
+	tags []string
+}
+
+func (s defaultTagsSession) DefaultTags() []string {
+	return s.tags
+}
+
+func TestEnvTags(t *testing.T) {
+	defer os.Setenv("IC_ENV_TAGS", os.Getenv("IC_ENV_TAGS"))
+
+	cases := []struct {
+		name        string
+		schematics  string
+		defaultTags []string
+		want        []string
+	}{
+		{"none", "", nil, nil},
+		{"schematics", "schematics:workspace,env:dev", nil, []string{"env:dev", "schematics:workspace"}},
+		{"default tags", "", []string{"owner:team"}, []string{"owner:team"}},
+		{"merged without duplicates", "env:dev", []string{"env:dev", "owner:team"}, []string{"env:dev", "owner:team"}},
+	}
+	for _, c := range cases {
+		os.Setenv("IC_ENV_TAGS", c.schematics)
+		got := EnvTags(defaultTagsSession{tags: c.defaultTags})
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+
+	os.Setenv("IC_ENV_TAGS", "env:dev")
+	if got := EnvTags(nil); !reflect.DeepEqual(got, []string{"env:dev"}) {
+		t.Errorf("expected the environment tags without a provider meta, got %v", got)
+	}
+}
+
+func TestWithoutEnvTags(t *testing.T) {
+	got := withoutEnvTags([]string{"env:dev", "app:web", "owner:team"}, []string{"env:dev", "owner:team"})
+	if !reflect.DeepEqual(got, []string{"app:web"}) {
+		t.Errorf("got %v, want [app:web]", got)
+	}
+	if got := withoutEnvTags([]string{"app:web"}, nil); !reflect.DeepEqual(got, []string{"app:web"}) {
+		t.Errorf("got %v, want [app:web]", got)
+	}
+}
+
+func TestOnlyEnvTagsRemoved(t *testing.T) {
+	envTags := []string{"env:dev", "owner:team"}
+	cases := []struct {
+		name   string
+		remove []interface{}
+		add    []interface{}
+		want   bool
+	}{
+		{"one env tag", []interface{}{"env:dev"}, nil, true},
+		{"all env tags", []interface{}{"env:dev", "owner:team"}, nil, true},
+		{"user tag", []interface{}{"env:dev", "app:web"}, nil, false},
+		{"tag added", []interface{}{"env:dev"}, []interface{}{"app:web"}, false},
+	}
+	for _, c := range cases {
+		if got := onlyEnvTagsRemoved(c.remove, c.add, envTags); got != c.want {
+			t.Errorf("%s: got %t, want %t", c.name, got, c.want)
+		}
+	}
+}
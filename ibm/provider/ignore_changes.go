@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ignoreChangesRule is an ignore_changes block of the provider, with patterns
+// of resource types and of top-level attribute names.
+type ignoreChangesRule struct {
+	resourceTypes []string
+	attributes    []string
+}
+
+// expandIgnoreChangesRules validates and expands the ignore_changes blocks of
+// the provider.
+func expandIgnoreChangesRules(blocks []interface{}) ([]ignoreChangesRule, error) {
+	rules := make([]ignoreChangesRule, 0, len(blocks))
+	for _, b := range blocks {
+		if b == nil {
+			continue
+		}
+		block := b.(map[string]interface{})
+		rule := ignoreChangesRule{}
+		for _, p := range block["resource_types"].([]interface{}) {
+			rule.resourceTypes = append(rule.resourceTypes, p.(string))
+		}
+		for _, p := range block["attributes"].([]interface{}) {
+			rule.attributes = append(rule.attributes, p.(string))
+		}
+		for _, p := range append(append([]string{}, rule.resourceTypes...), rule.attributes...) {
+			if _, err := path.Match(p, ""); err != nil {
+				return nil, fmt.Errorf("[ERROR] Invalid ignore_changes pattern %q: %s", p, err)
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// ignoredAttributes returns, per resource type, the top-level attributes whose
+// changes the rules ignore. Only computed attributes can be cleared from a
+// diff, so a rule that matches an attribute that is not computed, or that
+// matches no attribute at all, is rejected.
+func ignoredAttributes(rules []ignoreChangesRule, resources map[string]*schema.Resource) (map[string][]string, error) {
+	names := make([]string, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	ignored := map[string][]string{}
+	for _, rule := range rules {
+		matched := false
+		unsupported := []string{}
+		for _, name := range names {
+			if len(rule.resourceTypes) > 0 && !ignoreChangesMatchAny(rule.resourceTypes, name) {
+				continue
+			}
+			attributes := make([]string, 0, len(resources[name].Schema))
+			for attribute := range resources[name].Schema {
+				attributes = append(attributes, attribute)
+			}
+			sort.Strings(attributes)
+			for _, attribute := range attributes {
+				if !ignoreChangesMatchAny(rule.attributes, attribute) {
+					continue
+				}
+				matched = true
+				if !resources[name].Schema[attribute].Computed {
+					unsupported = append(unsupported, name+"."+attribute)
+					continue
+				}
+				ignored[name] = appendUnique(ignored[name], attribute)
+			}
+		}
+		if !matched {
+			return nil, fmt.Errorf("[ERROR] The ignore_changes rule for attributes %s matches no attribute of the resource types %s",
+				strings.Join(rule.attributes, ", "), ignoreChangesResourceTypes(rule))
+		}
+		if len(unsupported) > 0 {
+			if len(unsupported) > 5 {
+				unsupported = append(unsupported[:5], fmt.Sprintf("and %d more", len(unsupported)-5))
+			}
+			return nil, fmt.Errorf("[ERROR] The ignore_changes rule for attributes %s matches attributes that are not computed and cannot be ignored: %s. Restrict the rule with resource_types",
+				strings.Join(rule.attributes, ", "), strings.Join(unsupported, ", "))
+		}
+	}
+	return ignored, nil
+}
+
+func ignoreChangesResourceTypes(rule ignoreChangesRule) string {
+	if len(rule.resourceTypes) == 0 {
+		return "*"
+	}
+	return strings.Join(rule.resourceTypes, ", ")
+}
+
+func appendUnique(list []string, s string) []string {
+	for _, e := range list {
+		if e == s {
+			return list
+		}
+	}
+	return append(list, s)
+}
+
+// wrapIgnoreChanges adds the ignore_changes rules of the provider to the
+// CustomizeDiff of the resource name, after its own CustomizeDiff. The rules
+// are read from the provider meta, so that aliased providers keep their own.
+func wrapIgnoreChanges(name string, r *schema.Resource) {
+	ignore := func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+		sess, ok := v.(conns.ClientSession)
+		if !ok {
+			return nil
+		}
+		return resourceIgnoreChangesCustomizeDiff(sess.IgnoredAttributes(name), diff)
+	}
+	if r.CustomizeDiff == nil {
+		r.CustomizeDiff = ignore
+		return
+	}
+	r.CustomizeDiff = customdiff.Sequence(r.CustomizeDiff, ignore)
+}
+
+func resourceIgnoreChangesCustomizeDiff(attributes []string, diff *schema.ResourceDiff) error {
+	// Nothing is ignored on create, so that the configured values are set
+	if len(attributes) == 0 || diff.Id() == "" {
+		return nil
+	}
+	for _, attribute := range attributes {
+		if !diff.HasChange(attribute) {
+			continue
+		}
+		if err := diff.Clear(attribute); err != nil {
+			return fmt.Errorf("[ERROR] Error ignoring the changes of %s: %s", attribute, err)
+		}
+	}
+	return nil
+}
+
+func ignoreChangesMatchAny(patterns []string, s string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, s); ok {
+			return true
+		}
+	}
+	return false
+}
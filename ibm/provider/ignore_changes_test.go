@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+type ignoreChangesSession struct {
+	conns.ClientSession
+	ignored map[string][]string
+}
+
+func (s ignoreChangesSession) IgnoredAttributes(resourceType string) []string {
+	return s.ignored[resourceType]
+}
+
+func ignoreChangesTestResources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"ibm_is_vpc": {
+			Schema: map[string]*schema.Schema{
+				"name": {Type: schema.TypeString, Required: true},
+				"tags": {Type: schema.TypeSet, Optional: true, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}, Set: schema.HashString},
+			},
+		},
+		"ibm_iam_access_group": {
+			Schema: map[string]*schema.Schema{
+				"name": {Type: schema.TypeString, Required: true},
+				"tags": {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}, Set: schema.HashString},
+			},
+		},
+	}
+}
+
+func TestExpandIgnoreChangesRules(t *testing.T) {
+	rules, err := expandIgnoreChangesRules([]interface{}{
+		map[string]interface{}{
+			"resource_types": []interface{}{"ibm_is_*"},
+			"attributes":     []interface{}{"tags"},
+		},
+		nil,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []ignoreChangesRule{{resourceTypes: []string{"ibm_is_*"}, attributes: []string{"tags"}}}
+	if !reflect.DeepEqual(rules, want) {
+		t.Errorf("got %v, want %v", rules, want)
+	}
+
+	_, err = expandIgnoreChangesRules([]interface{}{
+		map[string]interface{}{
+			"resource_types": []interface{}{},
+			"attributes":     []interface{}{"[tags"},
+		},
+	})
+	if err == nil || !strings.Contains(err.Error(), "Invalid ignore_changes pattern") {
+		t.Errorf("expected an invalid pattern error, got %v", err)
+	}
+}
+
+func TestIgnoredAttributes(t *testing.T) {
+	resources := ignoreChangesTestResources()
+
+	ignored, err := ignoredAttributes([]ignoreChangesRule{{resourceTypes: []string{"ibm_is_*"}, attributes: []string{"tags"}}}, resources)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string][]string{"ibm_is_vpc": {"tags"}}; !reflect.DeepEqual(ignored, want) {
+		t.Errorf("got %v, want %v", ignored, want)
+	}
+
+	_, err = ignoredAttributes([]ignoreChangesRule{{attributes: []string{"tags"}}}, resources)
+	if err == nil || !strings.Contains(err.Error(), "ibm_iam_access_group.tags") {
+		t.Errorf("expected attributes that are not computed to be rejected, got %v", err)
+	}
+
+	_, err = ignoredAttributes([]ignoreChangesRule{{resourceTypes: []string{"ibm_is_*"}, attributes: []string{"access_tags"}}}, resources)
+	if err == nil || !strings.Contains(err.Error(), "matches no attribute") {
+		t.Errorf("expected a rule that matches nothing to be rejected, got %v", err)
+	}
+}
+
+func TestResourceIgnoreChangesCustomizeDiff(t *testing.T) {
+	r := ignoreChangesTestResources()["ibm_is_vpc"]
+	wrapIgnoreChanges("ibm_is_vpc", r)
+
+	state := &terraform.InstanceState{
+		ID: "r006-1234",
+		Attributes: map[string]string{
+			"id":     "r006-1234",
+			"name":   "vpc",
+			"tags.#": "1",
+			fmt.Sprintf("tags.%d", schema.HashString("env:dev")): "env:dev",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "renamed",
+		"tags": []interface{}{"env:prod"},
+	})
+
+	diff, err := r.Diff(context.Background(), state, config, ignoreChangesSession{ignored: map[string][]string{"ibm_is_vpc": {"tags"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for key := range diff.Attributes {
+		if strings.HasPrefix(key, "tags") {
+			t.Errorf("expected the changes of tags to be ignored, got a diff for %s", key)
+		}
+	}
+	if _, ok := diff.Attributes["name"]; !ok {
+		t.Errorf("expected the change of name to be kept, got %v", diff.Attributes)
+	}
+
+	diff, err = r.Diff(context.Background(), state, config, ignoreChangesSession{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tagsChanged := false
+	for key := range diff.Attributes {
+		if strings.HasPrefix(key, "tags.") {
+			tagsChanged = true
+		}
+	}
+	if !tagsChanged {
+		t.Errorf("expected the changes of tags to be kept without rules, got %v", diff.Attributes)
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// wrapListCache lets the context aware read of the VPC data source name serve
+// its collection list calls from the list cache of the VPC client. Resources
+// are never wrapped, so that their reads and plans always list the current
+// state.
+func wrapListCache(name string, r *schema.Resource) {
+	if !strings.HasPrefix(name, "ibm_is_") || r.ReadContext == nil {
+		return
+	}
+	read := r.ReadContext
+	r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return read(conns.WithListCache(ctx), d, meta)
+	}
+}
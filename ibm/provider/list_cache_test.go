@@ -0,0 +1,32 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+// The plural VPC data sources must read with a context, otherwise their list
+// calls do not go through the list cache.
+func TestListCacheDataSources(t *testing.T) {
+	dataSources := Provider().DataSourcesMap
+	for _, name := range []string{
+		"ibm_is_floating_ips",
+		"ibm_is_images",
+		"ibm_is_instances",
+		"ibm_is_public_gateways",
+		"ibm_is_security_groups",
+		"ibm_is_subnets",
+		"ibm_is_vpcs",
+	} {
+		r, ok := dataSources[name]
+		if !ok {
+			t.Errorf("%s: data source not found", name)
+			continue
+		}
+		if r.ReadContext == nil || r.Read != nil {
+			t.Errorf("%s: does not read with a context", name)
+		}
+	}
+}
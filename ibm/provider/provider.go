@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/apigateway"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/appconfiguration"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/appid"
@@ -54,7 +55,7 @@ import (
 
 // Provider returns a *schema.Provider.
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"bluemix_api_key": {
 				Type:        schema.TypeString,
@@ -210,6 +211,51 @@ func Provider() *schema.Provider {
 				Description: "Path of the file that contains private and public regional endpoints mapping",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
 			},
+			"telemetry_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of a JSON file to which the operation durations, API call counts and retries of each resource type are written",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_TELEMETRY_FILE", "IBMCLOUD_TELEMETRY_FILE"}, nil),
+			},
+			"ignore_changes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Rules of attributes whose changes are ignored on all the matching resources, for example tags managed by external tooling.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_types": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Patterns of the resource types that the rule applies to, for example ibm_is_*. The rule applies to all resource types if not set.",
+						},
+						"attributes": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Patterns of the top-level attributes whose changes are ignored, for example tags or *_tags.",
+						},
+					},
+				},
+			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags that are attached to every taggable resource managed by the provider.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_resource_instance", "tag")},
+							Set:         flex.ResourceIBMVPCHash,
+							Description: "List of user tags.",
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -281,6 +327,7 @@ func Provider() *schema.Provider {
 			"ibm_cis_filters":                       cis.DataSourceIBMCISFilters(),
 			"ibm_cis_firewall_rules":                cis.DataSourceIBMCISFirewallRules(),
 			"ibm_cloudant":                          cloudant.DataSourceIBMCloudant(),
+			"ibm_cloudant_replications":             cloudant.DataSourceIBMCloudantReplications(),
 			"ibm_database":                          database.DataSourceIBMDatabaseInstance(),
 			"ibm_database_connection":               database.DataSourceIBMDatabaseConnection(),
 			"ibm_compute_bare_metal":                classicinfrastructure.DataSourceIBMComputeBareMetal(),
@@ -315,6 +362,8 @@ func Provider() *schema.Provider {
 			"ibm_dns_secondary":                     classicinfrastructure.DataSourceIBMDNSSecondary(),
 			"ibm_event_streams_topic":               eventstreams.DataSourceIBMEventStreamsTopic(),
 			"ibm_event_streams_schema":              eventstreams.DataSourceIBMEventStreamsSchema(),
+			"ibm_event_streams_iam_resources":       eventstreams.DataSourceIBMEventStreamsIAMResources(),
+			"ibm_global_catalog_service":            resourcecontroller.DataSourceIBMGlobalCatalogService(),
 			"ibm_hpcs":                              hpcs.DataSourceIBMHPCS(),
 			"ibm_iam_access_group":                  iamaccessgroup.DataSourceIBMIAMAccessGroup(),
 			"ibm_iam_access_group_policy":           iampolicy.DataSourceIBMIAMAccessGroupPolicy(),
@@ -325,6 +374,7 @@ func Provider() *schema.Provider {
 			"ibm_iam_roles":                         iampolicy.DataSourceIBMIAMRole(),
 			"ibm_iam_user_policy":                   iampolicy.DataSourceIBMIAMUserPolicy(),
 			"ibm_iam_authorization_policies":        iampolicy.DataSourceIBMIAMAuthorizationPolicies(),
+			"ibm_iam_access_report":                 iampolicy.DataSourceIBMIAMAccessReport(),
 			"ibm_iam_user_profile":                  iamidentity.DataSourceIBMIAMUserProfile(),
 			"ibm_iam_service_id":                    iamidentity.DataSourceIBMIAMServiceID(),
 			"ibm_iam_service_policy":                iampolicy.DataSourceIBMIAMServicePolicy(),
@@ -418,6 +468,7 @@ func Provider() *schema.Provider {
 			"ibm_is_region":                      vpc.DataSourceIBMISRegion(),
 			"ibm_is_regions":                     vpc.DataSourceIBMISRegions(),
 			"ibm_is_ssh_key":                     vpc.DataSourceIBMISSSHKey(),
+			"ibm_is_ssh_keys":                    vpc.DataSourceIBMIsSSHKeys(),
 			"ibm_is_subnet":                      vpc.DataSourceIBMISSubnet(),
 			"ibm_is_subnets":                     vpc.DataSourceIBMISSubnets(),
 			"ibm_is_subnet_reserved_ip":          vpc.DataSourceIBMISReservedIP(),
@@ -439,6 +490,8 @@ func Provider() *schema.Provider {
 			"ibm_is_vpn_gateways":                vpc.DataSourceIBMISVPNGateways(),
 			"ibm_is_vpc_address_prefixes":        vpc.DataSourceIbmIsVpcAddressPrefixes(),
 			"ibm_is_vpc_address_prefix":          vpc.DataSourceIBMIsVPCAddressPrefix(),
+			"ibm_is_vpc_address_prefix_usage":    vpc.DataSourceIBMIsVPCAddressPrefixUsage(),
+			"ibm_is_vpc_public_exposure":         vpc.DataSourceIBMIsVPCPublicExposure(),
 			"ibm_is_vpn_gateway_connection":      vpc.DataSourceIBMISVPNGatewayConnection(),
 			"ibm_is_vpn_gateway_connections":     vpc.DataSourceIBMISVPNGatewayConnections(),
 			"ibm_is_vpc_default_routing_table":   vpc.DataSourceIBMISVPCDefaultRoutingTable(),
@@ -466,6 +519,7 @@ func Provider() *schema.Provider {
 			"ibm_pn_application_chrome":          pushnotification.DataSourceIBMPNApplicationChrome(),
 			"ibm_app_config_environment":         appconfiguration.DataSourceIBMAppConfigEnvironment(),
 			"ibm_app_config_environments":        appconfiguration.DataSourceIBMAppConfigEnvironments(),
+			"ibm_app_config_evaluation":          appconfiguration.DataSourceIBMAppConfigEvaluation(),
 			"ibm_app_config_feature":             appconfiguration.DataSourceIBMAppConfigFeature(),
 			"ibm_app_config_features":            appconfiguration.DataSourceIBMAppConfigFeatures(),
 
@@ -473,6 +527,7 @@ func Provider() *schema.Provider {
 			"ibm_resource_group":    resourcemanager.DataSourceIBMResourceGroup(),
 			"ibm_resource_instance": resourcecontroller.DataSourceIBMResourceInstance(),
 			"ibm_resource_key":      resourcecontroller.DataSourceIBMResourceKey(),
+			"ibm_resource_keys":     resourcecontroller.DataSourceIBMResourceKeys(),
 			"ibm_security_group":    classicinfrastructure.DataSourceIBMSecurityGroup(),
 			"ibm_service_instance":  cloudfoundry.DataSourceIBMServiceInstance(),
 			"ibm_service_key":       cloudfoundry.DataSourceIBMServiceKey(),
@@ -556,10 +611,12 @@ func Provider() *schema.Provider {
 			"ibm_enterprises":               enterprise.DataSourceIBMEnterprises(),
 			"ibm_enterprise_account_groups": enterprise.DataSourceIBMEnterpriseAccountGroups(),
 			"ibm_enterprise_accounts":       enterprise.DataSourceIBMEnterpriseAccounts(),
+			"ibm_enterprise_hierarchy":      enterprise.DataSourceIBMEnterpriseHierarchy(),
 
 			// //Added for Secrets Manager
-			"ibm_secrets_manager_secrets": secretsmanager.DataSourceIBMSecretsManagerSecrets(),
-			"ibm_secrets_manager_secret":  secretsmanager.DataSourceIBMSecretsManagerSecret(),
+			"ibm_secrets_manager_secrets":   secretsmanager.DataSourceIBMSecretsManagerSecrets(),
+			"ibm_secrets_manager_secret":    secretsmanager.DataSourceIBMSecretsManagerSecret(),
+			"ibm_secrets_manager_inventory": secretsmanager.DataSourceIBMSecretsManagerInventory(),
 
 			// //Added for Satellite
 			"ibm_satellite_location":                            satellite.DataSourceIBMSatelliteLocation(),
@@ -569,6 +626,7 @@ func Provider() *schema.Provider {
 			"ibm_satellite_cluster_worker_pool":                 satellite.DataSourceIBMSatelliteClusterWorkerPool(),
 			"ibm_satellite_link":                                satellite.DataSourceIBMSatelliteLink(),
 			"ibm_satellite_endpoint":                            satellite.DataSourceIBMSatelliteEndpoint(),
+			"ibm_satellite_endpoint_stats":                      satellite.DataSourceIBMSatelliteEndpointStats(),
 			"ibm_satellite_cluster_worker_pool_zone_attachment": satellite.DataSourceIBMSatelliteClusterWorkerPoolAttachment(),
 
 			// // Catalog related resources
@@ -581,9 +639,10 @@ func Provider() *schema.Provider {
 			"ibm_resource_tag": globaltagging.DataSourceIBMResourceTag(),
 
 			// // Atracker
-			"ibm_atracker_targets":   atracker.DataSourceIBMAtrackerTargets(),
-			"ibm_atracker_routes":    atracker.DataSourceIBMAtrackerRoutes(),
-			"ibm_atracker_endpoints": atracker.DataSourceIBMAtrackerEndpoints(),
+			"ibm_atracker_targets":      atracker.DataSourceIBMAtrackerTargets(),
+			"ibm_atracker_routes":       atracker.DataSourceIBMAtrackerRoutes(),
+			"ibm_atracker_endpoints":    atracker.DataSourceIBMAtrackerEndpoints(),
+			"ibm_atracker_route_status": atracker.DataSourceIBMAtrackerRouteStatus(),
 
 			//Security and Compliance Center
 			"ibm_scc_si_providers":                  scc.DataSourceIBMSccSiProviders(),
@@ -693,6 +752,7 @@ func Provider() *schema.Provider {
 			"ibm_cis_cache_settings":                    cis.ResourceIBMCISCacheSettings(),
 			"ibm_cis_custom_page":                       cis.ResourceIBMCISCustomPage(),
 			"ibm_cis_waf_rule":                          cis.ResourceIBMCISWAFRule(),
+			"ibm_cis_waf_rule_overrides":                cis.ResourceIBMCISWAFRuleOverrides(),
 			"ibm_cis_certificate_order":                 cis.ResourceIBMCISCertificateOrder(),
 			"ibm_cis_filter":                            cis.ResourceIBMCISFilter(),
 			"ibm_cis_firewall_rule":                     cis.ResourceIBMCISFirewallrules(),
@@ -728,10 +788,12 @@ func Provider() *schema.Provider {
 			"ibm_container_nlb_dns":                     kubernetes.ResourceIBMContainerNlbDns(),
 			"ibm_cr_namespace":                          registry.ResourceIBMCrNamespace(),
 			"ibm_cr_retention_policy":                   registry.ResourceIBMCrRetentionPolicy(),
+			"ibm_cr_pull_token":                         registry.ResourceIBMCrPullToken(),
 			"ibm_ob_logging":                            kubernetes.ResourceIBMObLogging(),
 			"ibm_ob_monitoring":                         kubernetes.ResourceIBMObMonitoring(),
 			"ibm_cos_bucket":                            cos.ResourceIBMCOSBucket(),
 			"ibm_cos_bucket_object":                     cos.ResourceIBMCOSBucketObject(),
+			"ibm_cos_bucket_public_access_block":        cos.ResourceIBMCOSBucketPublicAccessBlock(),
 			"ibm_dns_domain":                            classicinfrastructure.ResourceIBMDNSDomain(),
 			"ibm_dns_domain_registration_nameservers":   classicinfrastructure.ResourceIBMDNSDomainRegistrationNameservers(),
 			"ibm_dns_secondary":                         classicinfrastructure.ResourceIBMDNSSecondary(),
@@ -801,6 +863,7 @@ func Provider() *schema.Provider {
 			"ibm_is_network_acl_rule":                            vpc.ResourceIBMISNetworkACLRule(),
 			"ibm_is_public_gateway":                              vpc.ResourceIBMISPublicGateway(),
 			"ibm_is_security_group":                              vpc.ResourceIBMISSecurityGroup(),
+			"ibm_is_vpc_default_security_group":                  vpc.ResourceIBMISVPCDefaultSecurityGroup(),
 			"ibm_is_security_group_rule":                         vpc.ResourceIBMISSecurityGroupRule(),
 			"ibm_is_security_group_target":                       vpc.ResourceIBMISSecurityGroupTarget(),
 			"ibm_is_security_group_network_interface_attachment": vpc.ResourceIBMISSecurityGroupNetworkInterfaceAttachment(),
@@ -811,6 +874,7 @@ func Provider() *schema.Provider {
 			"ibm_is_subnet_routing_table_attachment":             vpc.ResourceIBMISSubnetRoutingTableAttachment(),
 			"ibm_is_ssh_key":                                     vpc.ResourceIBMISSSHKey(),
 			"ibm_is_snapshot":                                    vpc.ResourceIBMSnapshot(),
+			"ibm_is_instance_snapshots":                          vpc.ResourceIBMISInstanceSnapshots(),
 			"ibm_is_volume":                                      vpc.ResourceIBMISVolume(),
 			"ibm_is_vpn_gateway":                                 vpc.ResourceIBMISVPNGateway(),
 			"ibm_is_vpn_gateway_connection":                      vpc.ResourceIBMISVPNGatewayConnection(),
@@ -818,6 +882,7 @@ func Provider() *schema.Provider {
 			"ibm_is_vpc_address_prefix":                          vpc.ResourceIBMISVpcAddressPrefix(),
 			"ibm_is_vpc_route":                                   vpc.ResourceIBMISVpcRoute(),
 			"ibm_is_vpc_routing_table":                           vpc.ResourceIBMISVPCRoutingTable(),
+			"ibm_is_vpc_default_routing_table":                   vpc.ResourceIBMISVPCDefaultRoutingTable(),
 			"ibm_is_vpc_routing_table_route":                     vpc.ResourceIBMISVPCRoutingTableRoute(),
 			"ibm_is_image":                                       vpc.ResourceIBMISImage(),
 			"ibm_lb":                                             classicinfrastructure.ResourceIBMLb(),
@@ -908,6 +973,7 @@ func Provider() *schema.Provider {
 			"ibm_tg_gateway":      transitgateway.ResourceIBMTransitGateway(),
 			"ibm_tg_connection":   transitgateway.ResourceIBMTransitGatewayConnection(),
 			"ibm_tg_route_report": transitgateway.ResourceIBMTransitGatewayRouteReport(),
+			"ibm_tg_vpc_peering":  transitgateway.ResourceIBMTransitGatewayVpcPeering(),
 
 			// //Catalog related resources
 			"ibm_cm_offering_instance": catalogmanagement.ResourceIBMCmOfferingInstance(),
@@ -957,6 +1023,7 @@ func Provider() *schema.Provider {
 			"ibm_scc_posture_collector":  scc.ResourceIBMSccPostureCollectors(),
 			"ibm_scc_posture_scope":      scc.ResourceIBMSccPostureScopes(),
 			"ibm_scc_posture_credential": scc.ResourceIBMSccPostureCredentials(),
+			"ibm_scc_posture_scan":       scc.ResourceIBMSccPostureScan(),
 
 			// // Added for Context Based Restrictions
 			"ibm_cbr_zone": contextbasedrestrictions.ResourceIBMCbrZone(),
@@ -975,9 +1042,19 @@ func Provider() *schema.Provider {
 			"ibm_en_subscription_android": eventnotification.ResourceIBMEnFCMSubscription(),
 			"ibm_en_subscription_ios":     eventnotification.ResourceIBMEnFCMSubscription(),
 		},
-
-		ConfigureFunc: providerConfigure,
 	}
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		return providerConfigure(d, provider.ResourcesMap)
+	}
+	for name, r := range provider.DataSourcesMap {
+		wrapTelemetry(name, r)
+		wrapListCache(name, r)
+	}
+	for name, r := range provider.ResourcesMap {
+		wrapTelemetry(name, r)
+		wrapIgnoreChanges(name, r)
+	}
+	return provider
 }
 
 var globalValidatorDict validate.ValidatorDict
@@ -1009,6 +1086,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_cis_firewall":              cis.ResourceIBMCISFirewallValidator(),
 				"ibm_cis_range_app":             cis.ResourceIBMCISRangeAppValidator(),
 				"ibm_cis_waf_rule":              cis.ResourceIBMCISWAFRuleValidator(),
+				"ibm_cis_waf_rule_overrides":    cis.ResourceIBMCISWAFRuleOverridesValidator(),
 				"ibm_cis_certificate_order":     cis.ResourceIBMCISCertificateOrderValidator(),
 				"ibm_cis_filter":                cis.ResourceIBMCISFilterValidator(),
 				"ibm_cis_firewall_rules":        cis.ResourceIBMCISFirewallrulesValidator(),
@@ -1069,6 +1147,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_is_security_group_rule":              vpc.ResourceIBMISSecurityGroupRuleValidator(),
 				"ibm_is_security_group":                   vpc.ResourceIBMISSecurityGroupValidator(),
 				"ibm_is_snapshot":                         vpc.ResourceIBMISSnapshotValidator(),
+				"ibm_is_instance_snapshots":               vpc.ResourceIBMISInstanceSnapshotsValidator(),
 				"ibm_is_ssh_key":                          vpc.ResourceIBMISSHKeyValidator(),
 				"ibm_is_subnet":                           vpc.ResourceIBMISSubnetValidator(),
 				"ibm_is_subnet_reserved_ip":               vpc.ResourceIBMISSubnetReservedIPValidator(),
@@ -1124,19 +1203,20 @@ func Validator() validate.ValidatorDict {
 				// bare_metal_server
 				"ibm_is_bare_metal_server": vpc.DataSourceIBMIsBareMetalServerValidator(),
 
-				"ibm_is_vpc":                  vpc.DataSourceIBMISVpcValidator(),
-				"ibm_is_volume":               vpc.DataSourceIBMISVolumeValidator(),
-				"ibm_scc_si_notes":            scc.DataSourceIBMSccSiNotesValidator(),
-				"ibm_scc_si_occurrences":      scc.DataSourceIBMSccSiOccurrencesValidator(),
-				"ibm_secrets_manager_secret":  secretsmanager.DataSourceIBMSecretsManagerSecretValidator(),
-				"ibm_secrets_manager_secrets": secretsmanager.DataSourceIBMSecretsManagerSecretsValidator(),
+				"ibm_is_vpc":                    vpc.DataSourceIBMISVpcValidator(),
+				"ibm_is_volume":                 vpc.DataSourceIBMISVolumeValidator(),
+				"ibm_scc_si_notes":              scc.DataSourceIBMSccSiNotesValidator(),
+				"ibm_scc_si_occurrences":        scc.DataSourceIBMSccSiOccurrencesValidator(),
+				"ibm_secrets_manager_secret":    secretsmanager.DataSourceIBMSecretsManagerSecretValidator(),
+				"ibm_secrets_manager_secrets":   secretsmanager.DataSourceIBMSecretsManagerSecretsValidator(),
+				"ibm_secrets_manager_inventory": secretsmanager.DataSourceIBMSecretsManagerInventoryValidator(),
 			},
 		}
 	})
 	return globalValidatorDict
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfigure(d *schema.ResourceData, resources map[string]*schema.Resource) (interface{}, error) {
 	var bluemixAPIKey string
 	var bluemixTimeout int
 	var iamToken, iamRefreshToken, iamTrustedProfileId string
@@ -1213,6 +1293,27 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		os.Setenv("FUNCTION_NAMESPACE", wskNameSpace)
 	}
 
+	// Default tags are kept on the client session of this provider, so that aliased
+	// providers each attach their own
+	var defaultTags []string
+	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		defaultTags = flex.ExpandStringList(v.([]interface{})[0].(map[string]interface{})["tags"].(*schema.Set).List())
+	}
+
+	ignoreChangesRules, err := expandIgnoreChangesRules(d.Get("ignore_changes").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	ignored, err := ignoredAttributes(ignoreChangesRules, resources)
+	if err != nil {
+		return nil, err
+	}
+
+	// Enabled before the clients are created, so that their API calls are recorded
+	if f, ok := d.GetOk("telemetry_file"); ok {
+		conns.EnableTelemetry(f.(string))
+	}
+
 	config := conns.Config{
 		BluemixAPIKey:        bluemixAPIKey,
 		Region:               region,
@@ -1232,6 +1333,8 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Visibility:           visibility,
 		EndpointsFile:        file,
 		IAMTrustedProfileID:  iamTrustedProfileId,
+		DefaultTags:          defaultTags,
+		IgnoredAttributes:    ignored,
 	}
 
 	return config.ClientSession()
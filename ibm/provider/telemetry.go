@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// wrapTelemetry records the create, read, update and delete operations of the
+// resource or data source name when the telemetry_file of the provider is set.
+// The context of the context aware operations attributes the API calls made
+// with it to name. The legacy operations have no context to scope, the API
+// calls they make are recorded under conns.TelemetryProviderScope.
+func wrapTelemetry(name string, r *schema.Resource) {
+	r.Create = wrapTelemetryFunc(name, "create", r.Create)
+	r.Read = wrapTelemetryFunc(name, "read", r.Read)
+	r.Update = wrapTelemetryFunc(name, "update", r.Update)
+	r.Delete = wrapTelemetryFunc(name, "delete", r.Delete)
+	r.CreateContext = wrapTelemetryContextFunc(name, "create", r.CreateContext)
+	r.ReadContext = wrapTelemetryContextFunc(name, "read", r.ReadContext)
+	r.UpdateContext = wrapTelemetryContextFunc(name, "update", r.UpdateContext)
+	r.DeleteContext = wrapTelemetryContextFunc(name, "delete", r.DeleteContext)
+}
+
+func wrapTelemetryFunc(name, operation string, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta interface{}) error {
+		if !conns.TelemetryEnabled() {
+			return f(d, meta)
+		}
+		start := time.Now()
+		err := f(d, meta)
+		conns.RecordTelemetryOperation(name, operation, start, err != nil)
+		return err
+	}
+}
+
+func wrapTelemetryContextFunc(name, operation string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if !conns.TelemetryEnabled() {
+			return f(ctx, d, meta)
+		}
+		start := time.Now()
+		diags := f(conns.WithTelemetryScope(ctx, name), d, meta)
+		conns.RecordTelemetryOperation(name, operation, start, diags.HasError())
+		return diags
+	}
+}
@@ -0,0 +1,264 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appconfiguration
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+)
+
+// The segment rule value that stands for the enabled value of the feature, or
+// the value of the property.
+const appConfigDefaultValue = "$default"
+
+func DataSourceIBMAppConfigEvaluation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIbmAppConfigEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			"guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "GUID of the App Configuration service. Get it from the service instance credentials section of the dashboard.",
+			},
+			"environment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Environment Id.",
+			},
+			"feature_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"feature_id", "property_id"},
+				Description:  "Id of the feature flag to evaluate.",
+			},
+			"property_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"feature_id", "property_id"},
+				Description:  "Id of the property to evaluate.",
+			},
+			"entity_attributes": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Attributes of the entity for which the feature flag or property is evaluated, matched against the rules of the segments.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the feature flag or property, BOOLEAN, NUMERIC or STRING.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the feature flag is enabled, always true for a property.",
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The evaluated value, as a string.",
+			},
+			"segment_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Id of the segment the entity matched, empty if the value is not from a segment rule.",
+			},
+		},
+	}
+}
+
+func dataSourceIbmAppConfigEvaluationRead(d *schema.ResourceData, meta interface{}) error {
+	guid := d.Get("guid").(string)
+	environmentID := d.Get("environment_id").(string)
+
+	appconfigClient, err := getAppConfigClient(meta, guid)
+	if err != nil {
+		return err
+	}
+
+	var id, valueType string
+	var segmentRules []appconfigurationv1.SegmentRule
+	var enabled bool
+	var defaultValue, disabledValue interface{}
+	if featureID, ok := d.GetOk("feature_id"); ok {
+		options := &appconfigurationv1.GetFeatureOptions{}
+		options.SetEnvironmentID(environmentID)
+		options.SetFeatureID(featureID.(string))
+		result, response, err := appconfigClient.GetFeature(options)
+		if err != nil {
+			log.Printf("[DEBUG] GetFeature failed %s\n%s", err, response)
+			return err
+		}
+		id = *result.FeatureID
+		valueType = *result.Type
+		segmentRules = result.SegmentRules
+		enabled = result.Enabled != nil && *result.Enabled
+		defaultValue = result.EnabledValue
+		disabledValue = result.DisabledValue
+	} else {
+		options := &appconfigurationv1.GetPropertyOptions{}
+		options.SetEnvironmentID(environmentID)
+		options.SetPropertyID(d.Get("property_id").(string))
+		result, response, err := appconfigClient.GetProperty(options)
+		if err != nil {
+			log.Printf("[DEBUG] GetProperty failed %s\n%s", err, response)
+			return err
+		}
+		id = *result.PropertyID
+		valueType = *result.Type
+		segmentRules = result.SegmentRules
+		enabled = true
+		defaultValue = result.Value
+	}
+
+	attributes := map[string]string{}
+	for name, value := range d.Get("entity_attributes").(map[string]interface{}) {
+		attributes[name] = value.(string)
+	}
+
+	value := disabledValue
+	segmentID := ""
+	if enabled {
+		segments := map[string]*appconfigurationv1.Segment{}
+		getSegment := func(id string) (*appconfigurationv1.Segment, error) {
+			if segment, ok := segments[id]; ok {
+				return segment, nil
+			}
+			options := &appconfigurationv1.GetSegmentOptions{}
+			options.SetSegmentID(id)
+			segment, response, err := appconfigClient.GetSegment(options)
+			if err != nil {
+				log.Printf("[DEBUG] GetSegment failed %s\n%s", err, response)
+				return nil, err
+			}
+			segments[id] = segment
+			return segment, nil
+		}
+		value, segmentID, err = appConfigEvaluateSegmentRules(segmentRules, defaultValue, attributes, getSegment)
+		if err != nil {
+			return err
+		}
+	}
+
+	valueString, err := appConfigValueToString(value)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error encoding the evaluated value: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", guid, environmentID, id))
+	d.Set("type", valueType)
+	d.Set("enabled", enabled)
+	d.Set("value", valueString)
+	d.Set("segment_id", segmentID)
+	return nil
+}
+
+// appConfigValueToString returns a string value as is, and any other value,
+// such as a boolean, a number or a JSON property, encoded as JSON.
+func appConfigValueToString(value interface{}) (string, error) {
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// appConfigEvaluateSegmentRules returns the value of the first segment rule, in
+// order, with a segment that the entity belongs to, and the ID of the segment.
+// It returns defaultValue if the entity belongs to none.
+func appConfigEvaluateSegmentRules(segmentRules []appconfigurationv1.SegmentRule, defaultValue interface{}, attributes map[string]string, getSegment func(string) (*appconfigurationv1.Segment, error)) (interface{}, string, error) {
+	rules := make([]appconfigurationv1.SegmentRule, len(segmentRules))
+	copy(rules, segmentRules)
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].Order != nil && rules[j].Order != nil && *rules[i].Order < *rules[j].Order
+	})
+	for _, rule := range rules {
+		for _, target := range rule.Rules {
+			for _, segmentID := range target.Segments {
+				segment, err := getSegment(segmentID)
+				if err != nil {
+					return nil, "", err
+				}
+				if !appConfigSegmentMatches(segment.Rules, attributes) {
+					continue
+				}
+				if value, ok := rule.Value.(string); ok && value == appConfigDefaultValue {
+					return defaultValue, segmentID, nil
+				}
+				return rule.Value, segmentID, nil
+			}
+		}
+	}
+	return defaultValue, "", nil
+}
+
+// appConfigSegmentMatches reports whether the entity attributes match all the
+// rules of a segment. A rule matches if the attribute matches any of its values.
+func appConfigSegmentMatches(rules []appconfigurationv1.Rule, attributes map[string]string) bool {
+	for _, rule := range rules {
+		if rule.AttributeName == nil || rule.Operator == nil {
+			return false
+		}
+		attribute, ok := attributes[*rule.AttributeName]
+		if !ok {
+			return false
+		}
+		matched := false
+		for _, value := range rule.Values {
+			if appConfigRuleMatches(*rule.Operator, attribute, value) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func appConfigRuleMatches(operator, attribute, value string) bool {
+	switch operator {
+	case appconfigurationv1.Rule_Operator_Is:
+		return attribute == value
+	case appconfigurationv1.Rule_Operator_Contains:
+		return strings.Contains(attribute, value)
+	case appconfigurationv1.Rule_Operator_Startswith:
+		return strings.HasPrefix(attribute, value)
+	case appconfigurationv1.Rule_Operator_Endswith:
+		return strings.HasSuffix(attribute, value)
+	}
+	a, err := strconv.ParseFloat(attribute, 64)
+	if err != nil {
+		return false
+	}
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return false
+	}
+	switch operator {
+	case appconfigurationv1.Rule_Operator_Greaterthan:
+		return a > v
+	case appconfigurationv1.Rule_Operator_Greaterthanequals:
+		return a >= v
+	case appconfigurationv1.Rule_Operator_Lesserthan:
+		return a < v
+	case appconfigurationv1.Rule_Operator_Lesserthanequals:
+		return a <= v
+	}
+	log.Printf("[WARN] Unsupported App Configuration segment rule operator %s", operator)
+	return false
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appconfiguration_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIbmAppConfigEvaluationDataSource(t *testing.T) {
+	environmentID := "dev"
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	featureID := fmt.Sprintf("tf_feature_id_%d", acctest.RandIntRange(10, 100))
+	instanceName := fmt.Sprintf("tf_app_config_test_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmAppConfigEvaluationDataSourceConfigBasic(instanceName, name, environmentID, featureID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_app_config_evaluation.evaluation", "type", "STRING"),
+					resource.TestCheckResourceAttr("data.ibm_app_config_evaluation.evaluation", "enabled", "false"),
+					resource.TestCheckResourceAttr("data.ibm_app_config_evaluation.evaluation", "value", "old"),
+					resource.TestCheckResourceAttr("data.ibm_app_config_evaluation.evaluation", "segment_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmAppConfigEvaluationDataSourceConfigBasic(instanceName, name, environmentID, featureID string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "app_config_terraform_test482" {
+			name     = "%s"
+			location = "us-south"
+			service  = "apprapp"
+			plan     = "standard"
+		}
+
+		resource "ibm_app_config_feature" "app_config_feature_resource1" {
+			guid           = ibm_resource_instance.app_config_terraform_test482.guid
+			name           = "%s"
+			environment_id = "%s"
+			feature_id     = "%s"
+			type           = "STRING"
+			enabled_value  = "new"
+			disabled_value = "old"
+		}
+
+		data "ibm_app_config_evaluation" "evaluation" {
+			guid              = ibm_app_config_feature.app_config_feature_resource1.guid
+			environment_id    = ibm_app_config_feature.app_config_feature_resource1.environment_id
+			feature_id        = ibm_app_config_feature.app_config_feature_resource1.feature_id
+			entity_attributes = {
+				region = "us-south"
+			}
+		}
+		`, instanceName, name, environmentID, featureID)
+}
@@ -0,0 +1,31 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appconfiguration
+
+import (
+	"testing"
+)
+
+func TestAppConfigValueToString(t *testing.T) {
+	cases := []struct {
+		value interface{}
+		want  string
+	}{
+		{"blue", "blue"},
+		{true, "true"},
+		{float64(1.5), "1.5"},
+		{map[string]interface{}{"retries": float64(3)}, `{"retries":3}`},
+		{[]interface{}{"a", "b"}, `["a","b"]`},
+	}
+	for _, c := range cases {
+		got, err := appConfigValueToString(c.value)
+		if err != nil {
+			t.Errorf("%v: unexpected error %s", c.value, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("%v: got %s, want %s", c.value, got, c.want)
+		}
+	}
+}
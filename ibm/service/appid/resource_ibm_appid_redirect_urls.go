@@ -2,6 +2,7 @@ package appid
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -18,7 +19,7 @@ func ResourceIBMAppIDRedirectURLs() *schema.Resource {
 		UpdateContext: resourceIBMAppIDRedirectURLsUpdate,
 		DeleteContext: resourceIBMAppIDRedirectURLsDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceIBMAppIDRedirectURLsImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"tenant_id": {
@@ -35,6 +36,12 @@ func ResourceIBMAppIDRedirectURLs() *schema.Resource {
 				},
 				Required: true,
 			},
+			"exclusive": {
+				Description: "If set to false, only the URLs in `urls` are managed and the other redirect URLs of the tenant are kept",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
 		},
 	}
 }
@@ -55,7 +62,13 @@ func resourceIBMAppIDRedirectURLsRead(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("Error loading AppID Cloud Directory redirect urls: %s\n%s", err, resp)
 	}
 
-	if err := d.Set("urls", urls.RedirectUris); err != nil {
+	redirectURLs := urls.RedirectUris
+	if !appIDRedirectURLsExclusive(d) {
+		// Only report the URLs managed by this resource that still exist
+		redirectURLs = appIDRedirectURLsIntersect(flex.ExpandStringList(d.Get("urls").([]interface{})), urls.RedirectUris)
+	}
+
+	if err := d.Set("urls", redirectURLs); err != nil {
 		return diag.Errorf("Error setting AppID Cloud Directory redirect urls: %s", err)
 	}
 
@@ -75,15 +88,8 @@ func resourceIBMAppIDRedirectURLsCreate(ctx context.Context, d *schema.ResourceD
 	urls := d.Get("urls")
 
 	redirectURLs := flex.ExpandStringList(urls.([]interface{}))
-	resp, err := appIDClient.UpdateRedirectUrisWithContext(ctx, &appid.UpdateRedirectUrisOptions{
-		TenantID: &tenantID,
-		RedirectUrisArray: &appid.RedirectURIConfig{
-			RedirectUris: redirectURLs,
-		},
-	})
-
-	if err != nil {
-		return diag.Errorf("Error updating AppID Cloud Directory redirect URLs: %s\n%s", err, resp)
+	if err := appIDUpdateRedirectURLs(ctx, appIDClient, tenantID, appIDRedirectURLsExclusive(d), nil, redirectURLs); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId(tenantID)
@@ -98,18 +104,17 @@ func resourceIBMAppIDRedirectURLsUpdate(ctx context.Context, d *schema.ResourceD
 	}
 
 	tenantID := d.Get("tenant_id").(string)
-	urls := d.Get("urls")
+	oldURLs, newURLs := d.GetChange("urls")
 
-	redirectURLs := flex.ExpandStringList(urls.([]interface{}))
-	resp, err := appIDClient.UpdateRedirectUrisWithContext(ctx, &appid.UpdateRedirectUrisOptions{
-		TenantID: &tenantID,
-		RedirectUrisArray: &appid.RedirectURIConfig{
-			RedirectUris: redirectURLs,
-		},
-	})
+	// After a switch from exclusive mode the previous URLs are all URLs of the
+	// tenant, they are not owned by this resource.
+	remove := flex.ExpandStringList(oldURLs.([]interface{}))
+	if d.HasChange("exclusive") {
+		remove = nil
+	}
 
-	if err != nil {
-		return diag.Errorf("Error updating AppID Cloud Directory redirect URLs: %s\n%s", err, resp)
+	if err := appIDUpdateRedirectURLs(ctx, appIDClient, tenantID, appIDRedirectURLsExclusive(d), remove, flex.ExpandStringList(newURLs.([]interface{}))); err != nil {
+		return diag.FromErr(err)
 	}
 
 	return resourceIBMAppIDRedirectURLsRead(ctx, d, meta)
@@ -123,19 +128,98 @@ func resourceIBMAppIDRedirectURLsDelete(ctx context.Context, d *schema.ResourceD
 	}
 
 	tenantID := d.Get("tenant_id").(string)
+	urls := flex.ExpandStringList(d.Get("urls").([]interface{}))
+
+	if err := appIDUpdateRedirectURLs(ctx, appIDClient, tenantID, appIDRedirectURLsExclusive(d), urls, []string{}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceIBMAppIDRedirectURLsImport imports the redirect URLs of a tenant in
+// exclusive mode, the default, as defaults are not applied on import.
+func resourceIBMAppIDRedirectURLsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("exclusive", true)
+	return []*schema.ResourceData{d}, nil
+}
+
+// appIDRedirectURLsExclusive returns the exclusive mode of the resource, which
+// is true if it is not set in the state, like for resources created before
+// the argument was added.
+func appIDRedirectURLsExclusive(d *schema.ResourceData) bool {
+	exclusive, ok := d.GetOkExists("exclusive")
+	return !ok || exclusive.(bool)
+}
+
+// appIDUpdateRedirectURLs sets the redirect URLs of a tenant. If exclusive is
+// false, the current URLs of the tenant are read and only the URLs in remove
+// are replaced by the URLs in add, so that several resources, for example one
+// per application, can share the redirect URLs of a tenant.
+func appIDUpdateRedirectURLs(ctx context.Context, appIDClient *appid.AppIDManagementV4, tenantID string, exclusive bool, remove, add []string) error {
+	redirectURLs := add
+	if !exclusive {
+		conns.IbmMutexKV.Lock(tenantID)
+		defer conns.IbmMutexKV.Unlock(tenantID)
+
+		current, resp, err := appIDClient.GetRedirectUrisWithContext(ctx, &appid.GetRedirectUrisOptions{
+			TenantID: &tenantID,
+		})
+		if err != nil {
+			return fmt.Errorf("Error loading AppID Cloud Directory redirect urls: %s\n%s", err, resp)
+		}
+		redirectURLs = appIDRedirectURLsMerge(current.RedirectUris, remove, add)
+	}
 
 	resp, err := appIDClient.UpdateRedirectUrisWithContext(ctx, &appid.UpdateRedirectUrisOptions{
 		TenantID: &tenantID,
 		RedirectUrisArray: &appid.RedirectURIConfig{
-			RedirectUris: []string{},
+			RedirectUris: redirectURLs,
 		},
 	})
-
 	if err != nil {
-		return diag.Errorf("Error resetting AppID Cloud Directory redirect URLs: %s\n%s", err, resp)
+		return fmt.Errorf("Error updating AppID Cloud Directory redirect URLs: %s\n%s", err, resp)
 	}
+	return nil
+}
 
-	d.SetId("")
+// appIDRedirectURLsMerge removes the URLs in remove from current and appends
+// the URLs in add that are not in current yet, keeping the order of current.
+func appIDRedirectURLsMerge(current, remove, add []string) []string {
+	removed := make(map[string]bool, len(remove))
+	for _, u := range remove {
+		removed[u] = true
+	}
+	for _, u := range add {
+		delete(removed, u)
+	}
 
-	return nil
+	seen := make(map[string]bool, len(current)+len(add))
+	merged := []string{}
+	for _, u := range append(append([]string{}, current...), add...) {
+		if removed[u] || seen[u] {
+			continue
+		}
+		seen[u] = true
+		merged = append(merged, u)
+	}
+	return merged
+}
+
+// appIDRedirectURLsIntersect returns the URLs of managed that are in current,
+// in the order of managed.
+func appIDRedirectURLsIntersect(managed, current []string) []string {
+	exists := make(map[string]bool, len(current))
+	for _, u := range current {
+		exists[u] = true
+	}
+	urls := []string{}
+	for _, u := range managed {
+		if exists[u] {
+			urls = append(urls, u)
+		}
+	}
+	return urls
 }
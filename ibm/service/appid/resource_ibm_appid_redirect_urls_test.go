@@ -27,10 +27,77 @@ func TestAccIBMAppIDRedirectURLs_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("ibm_appid_redirect_urls.urls", "urls.2", "https://test-url-3.com"),
 				),
 			},
+			{
+				ResourceName:      "ibm_appid_redirect_urls.urls",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMAppIDRedirectURLs_nonExclusive(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMAppIDRedirectURLsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMAppIDRedirectURLsNonExclusiveConfig(acc.AppIDTenantID, "https://test-app-b-1.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_appid_redirect_urls.app_a", "urls.#", "1"),
+					resource.TestCheckResourceAttr("ibm_appid_redirect_urls.app_b", "urls.#", "1"),
+					testAccCheckIBMAppIDRedirectURLsCount(acc.AppIDTenantID, 2),
+				),
+			},
+			{
+				Config: testAccCheckIBMAppIDRedirectURLsNonExclusiveConfig(acc.AppIDTenantID, "https://test-app-b-2.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_appid_redirect_urls.app_a", "urls.0", "https://test-app-a-1.com"),
+					resource.TestCheckResourceAttr("ibm_appid_redirect_urls.app_b", "urls.0", "https://test-app-b-2.com"),
+					testAccCheckIBMAppIDRedirectURLsCount(acc.AppIDTenantID, 2),
+				),
+			},
 		},
 	})
 }
 
+func testAccCheckIBMAppIDRedirectURLsNonExclusiveConfig(tenantID, appBURL string) string {
+	return fmt.Sprintf(`
+		resource "ibm_appid_redirect_urls" "app_a" {
+			tenant_id = "%s"
+			exclusive = false
+			urls      = ["https://test-app-a-1.com"]
+		}
+
+		resource "ibm_appid_redirect_urls" "app_b" {
+			tenant_id = "%s"
+			exclusive = false
+			urls      = ["%s"]
+		}
+	`, tenantID, tenantID, appBURL)
+}
+
+func testAccCheckIBMAppIDRedirectURLsCount(tenantID string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		appIDClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).AppIDAPI()
+		if err != nil {
+			return err
+		}
+
+		urls, _, err := appIDClient.GetRedirectUris(&appid.GetRedirectUrisOptions{
+			TenantID: &tenantID,
+		})
+		if err != nil {
+			return err
+		}
+		if len(urls.RedirectUris) != count {
+			return fmt.Errorf("[ERROR] Expected %d AppID redirect URLs, got %v", count, urls.RedirectUris)
+		}
+		return nil
+	}
+}
+
 func testAccCheckIBMAppIDRedirectURLsConfig(tenantID string) string {
 	return fmt.Sprintf(`
 		resource "ibm_appid_redirect_urls" "urls" {
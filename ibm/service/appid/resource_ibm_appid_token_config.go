@@ -5,6 +5,8 @@ package appid
 
 import (
 	"context"
+	"fmt"
+	"regexp"
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -15,8 +17,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// appIDReservedClaims are the claims that App ID sets and that cannot be
+// overridden by a claim mapping.
+var appIDReservedClaims = []string{"iss", "aud", "sub", "iat", "exp", "amr", "tenant", "scope"}
+
+// validateAppIDClaimName validates the claim names of a token, which are JSON
+// keys without spaces that do not override a reserved claim.
+var validateAppIDClaimName = validation.All(
+	validation.StringMatch(regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`), "must start with a letter or underscore and contain only letters, digits, underscores, dots and dashes"),
+	validation.StringNotInSlice(appIDReservedClaims, false),
+)
+
 func ResourceIBMAppIDTokenConfig() *schema.Resource {
 	return &schema.Resource{
+		CustomizeDiff: resourceIBMAppIDTokenConfigClaimsDiff,
 		CreateContext: resourceIBMAppIDTokenConfigCreate,
 		ReadContext:   resourceIBMAppIDTokenConfigRead,
 		UpdateContext: resourceIBMAppIDTokenConfigUpdate,
@@ -77,9 +91,10 @@ func ResourceIBMAppIDTokenConfig() *schema.Resource {
 							Optional:    true,
 						},
 						"destination_claim": {
-							Description: "Optional: Defines the custom attribute that can override the current claim in token.",
-							Type:        schema.TypeString,
-							Optional:    true,
+							Description:  "Optional: Defines the custom attribute that can override the current claim in token.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateAppIDClaimName,
 						},
 					},
 				},
@@ -100,8 +115,9 @@ func ResourceIBMAppIDTokenConfig() *schema.Resource {
 							Optional: true,
 						},
 						"destination_claim": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateAppIDClaimName,
 						},
 					},
 				},
@@ -110,6 +126,21 @@ func ResourceIBMAppIDTokenConfig() *schema.Resource {
 	}
 }
 
+// resourceIBMAppIDTokenConfigClaimsDiff checks that the claim mappings have a
+// source claim, which only the roles source does not need.
+func resourceIBMAppIDTokenConfigClaimsDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"access_token_claim", "id_token_claim"} {
+		for _, item := range d.Get(key).(*schema.Set).List() {
+			cMap := item.(map[string]interface{})
+			source := cMap["source"].(string)
+			if cMap["source_claim"].(string) == "" && source != "roles" && source != "" {
+				return fmt.Errorf("[ERROR] %s with source %s requires a source_claim", key, source)
+			}
+		}
+	}
+	return nil
+}
+
 func resourceIBMAppIDTokenConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	appidClient, err := meta.(conns.ClientSession).AppIDAPI()
 
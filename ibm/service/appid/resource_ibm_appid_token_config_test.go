@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appid_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMAppIDTokenConfig_claimValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMAppIDTokenConfigClaim(acc.AppIDTenantID, "appid_custom", "employeeId", "sub"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected access_token_claim.\d+.destination_claim to not be any of`),
+			},
+			{
+				Config:      testAccCheckIBMAppIDTokenConfigClaim(acc.AppIDTenantID, "appid_custom", "employeeId", "employee id"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must start with a letter or underscore`),
+			},
+			{
+				Config:      testAccCheckIBMAppIDTokenConfigClaim(acc.AppIDTenantID, "attributes", "", "department"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`access_token_claim with source attributes requires a source_claim`),
+			},
+			{
+				Config: testAccCheckIBMAppIDTokenConfigClaim(acc.AppIDTenantID, "attributes", "department", "dept"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_appid_token_config.test_config", "access_token_claim.#", "1"),
+					resource.TestCheckResourceAttr("ibm_appid_token_config.test_config", "access_token_claim.0.source", "attributes"),
+					resource.TestCheckResourceAttr("ibm_appid_token_config.test_config", "access_token_claim.0.source_claim", "department"),
+					resource.TestCheckResourceAttr("ibm_appid_token_config.test_config", "access_token_claim.0.destination_claim", "dept"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMAppIDTokenConfigClaim(tenantID, source, sourceClaim, destinationClaim string) string {
+	return fmt.Sprintf(`
+		resource "ibm_appid_token_config" "test_config" {
+			tenant_id = "%s"
+
+			access_token_claim {
+				source = "%s"
+				source_claim = "%s"
+				destination_claim = "%s"
+			}
+		}
+	`, tenantID, source, sourceClaim, destinationClaim)
+}
@@ -0,0 +1,271 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package atracker
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/platform-services-go-sdk/atrackerv1"
+)
+
+const atrackerWriteStatusFailed = "failed"
+
+func DataSourceIBMAtrackerRouteStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMAtrackerRouteStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The region where the routes and targets are read from. Defaults to the provider region.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the route. When omitted, the status of every route is returned.",
+			},
+			"healthy": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether every route resolves all of its targets and no referenced target is in failed write status.",
+			},
+			"routes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The status of each route.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The uuid of the route resource.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the route.",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The crn of the route resource.",
+						},
+						"updated": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The timestamp of the route last updated time.",
+						},
+						"healthy": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether all rules of the route resolve their targets and none of them is in failed write status.",
+						},
+						"rules": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The status of each routing rule, in evaluation order.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"target_ids": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "The target IDs referenced by the rule.",
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+									"missing_target_ids": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "The target IDs referenced by the rule that do not exist in the region.",
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+									"has_failed_target": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Indicates whether any target referenced by the rule is in failed write status.",
+									},
+									"targets": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "The resolved targets of the rule.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"id": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The uuid of the target resource.",
+												},
+												"name": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The name of the target resource.",
+												},
+												"target_type": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The type of the target.",
+												},
+												"write_status": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The status of the last write attempt to the target, such as failed or success.",
+												},
+												"last_failure": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The timestamp of the last write failure.",
+												},
+												"reason_for_last_failure": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "Detailed description of the cause of the last write failure.",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMAtrackerRouteStatusRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	atrackerClient, err := atrackerClientForRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	routeList, response, err := atrackerClient.ListRoutesWithContext(context, &atrackerv1.ListRoutesOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] ListRoutesWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ListRoutesWithContext failed %s\n%s", err, response))
+	}
+
+	targetList, response, err := atrackerClient.ListTargetsWithContext(context, &atrackerv1.ListTargetsOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] ListTargetsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ListTargetsWithContext failed %s\n%s", err, response))
+	}
+
+	targets := make(map[string]atrackerv1.Target, len(targetList.Targets))
+	for _, target := range targetList.Targets {
+		if target.ID != nil {
+			targets[*target.ID] = target
+		}
+	}
+
+	routes := routeList.Routes
+	if v, ok := d.GetOk("name"); ok {
+		name := v.(string)
+		var matchRoutes []atrackerv1.Route
+		for _, route := range routeList.Routes {
+			if route.Name != nil && *route.Name == name {
+				matchRoutes = append(matchRoutes, route)
+			}
+		}
+		if len(matchRoutes) == 0 {
+			return diag.FromErr(fmt.Errorf("no Routes found with name %s", name))
+		}
+		routes = matchRoutes
+		d.SetId(name)
+	} else {
+		d.SetId(dataSourceIBMAtrackerRoutesID(d))
+	}
+
+	healthy := true
+	routeStatus := make([]map[string]interface{}, 0, len(routes))
+	for _, route := range routes {
+		routeMap := dataSourceIBMAtrackerRouteStatusRouteToMap(route, targets)
+		if !routeMap["healthy"].(bool) {
+			healthy = false
+		}
+		routeStatus = append(routeStatus, routeMap)
+	}
+
+	if err = d.Set("healthy", healthy); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting healthy: %s", err))
+	}
+	if err = d.Set("routes", routeStatus); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting routes %s", err))
+	}
+
+	return nil
+}
+
+func dataSourceIBMAtrackerRouteStatusRouteToMap(route atrackerv1.Route, targets map[string]atrackerv1.Target) map[string]interface{} {
+	routeMap := map[string]interface{}{}
+	if route.ID != nil {
+		routeMap["id"] = *route.ID
+	}
+	if route.Name != nil {
+		routeMap["name"] = *route.Name
+	}
+	if route.CRN != nil {
+		routeMap["crn"] = *route.CRN
+	}
+	if route.Updated != nil {
+		routeMap["updated"] = route.Updated.String()
+	}
+
+	healthy := true
+	rules := make([]map[string]interface{}, 0, len(route.Rules))
+	for _, rule := range route.Rules {
+		missing := []string{}
+		resolved := []map[string]interface{}{}
+		failed := false
+		for _, targetID := range rule.TargetIds {
+			target, ok := targets[targetID]
+			if !ok {
+				missing = append(missing, targetID)
+				continue
+			}
+			targetMap := map[string]interface{}{
+				"id": targetID,
+			}
+			if target.Name != nil {
+				targetMap["name"] = *target.Name
+			}
+			if target.TargetType != nil {
+				targetMap["target_type"] = *target.TargetType
+			}
+			if status := target.CosWriteStatus; status != nil {
+				if status.Status != nil {
+					targetMap["write_status"] = *status.Status
+					if *status.Status == atrackerWriteStatusFailed {
+						failed = true
+					}
+				}
+				if status.LastFailure != nil {
+					targetMap["last_failure"] = status.LastFailure.String()
+				}
+				if status.ReasonForLastFailure != nil {
+					targetMap["reason_for_last_failure"] = *status.ReasonForLastFailure
+				}
+			}
+			resolved = append(resolved, targetMap)
+		}
+		if failed || len(missing) > 0 {
+			healthy = false
+		}
+		rules = append(rules, map[string]interface{}{
+			"target_ids":         rule.TargetIds,
+			"missing_target_ids": missing,
+			"has_failed_target":  failed,
+			"targets":            resolved,
+		})
+	}
+	routeMap["rules"] = rules
+	routeMap["healthy"] = healthy
+
+	return routeMap
+}
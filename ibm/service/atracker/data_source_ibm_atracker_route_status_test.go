@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package atracker_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMAtrackerRouteStatusDataSourceBasic(t *testing.T) {
+	routeName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMAtrackerRouteStatusDataSourceConfigBasic(routeName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_atracker_route_status.atracker_route_status", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_atracker_route_status.atracker_route_status", "healthy"),
+					resource.TestCheckResourceAttr("data.ibm_atracker_route_status.atracker_route_status", "routes.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_atracker_route_status.atracker_route_status", "routes.0.name", routeName),
+					resource.TestCheckResourceAttr("data.ibm_atracker_route_status.atracker_route_status", "routes.0.rules.0.missing_target_ids.#", "0"),
+					resource.TestCheckResourceAttr("data.ibm_atracker_route_status.atracker_route_status", "routes.0.rules.0.targets.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ibm_atracker_route_status.atracker_route_status", "routes.0.rules.0.targets.0.id", "ibm_atracker_target.atracker_target", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMAtrackerRouteStatusDataSourceConfigBasic(routeName string) string {
+	return fmt.Sprintf(`
+		resource "ibm_atracker_target" "atracker_target" {
+			name = "my-cos-target"
+			target_type = "cloud_object_storage"
+			cos_endpoint {
+				endpoint = "s3.private.us-east.cloud-object-storage.appdomain.cloud"
+				target_crn = "crn:v1:bluemix:public:cloud-object-storage:global:a/11111111111111111111111111111111:22222222-2222-2222-2222-222222222222::"
+				bucket = "my-atracker-bucket"
+				api_key = "xxxxxxxxxxxxxx"
+			}
+		}
+
+		resource "ibm_atracker_route" "atracker_route" {
+			name = "%s"
+			receive_global_events = false
+			rules {
+				target_ids = [ ibm_atracker_target.atracker_target.id ]
+			}
+		}
+
+		data "ibm_atracker_route_status" "atracker_route_status" {
+			name = ibm_atracker_route.atracker_route.name
+		}
+	`, routeName)
+}
@@ -9,7 +9,6 @@ import (
 	"log"
 	"time"
 
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -21,6 +20,11 @@ func DataSourceIBMAtrackerRoutes() *schema.Resource {
 		ReadContext: dataSourceIBMAtrackerRoutesRead,
 
 		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The region where the routes are read from. Defaults to the provider region.",
+			},
 			"name": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -92,7 +96,7 @@ func DataSourceIBMAtrackerRoutes() *schema.Resource {
 }
 
 func dataSourceIBMAtrackerRoutesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	atrackerClient, err := meta.(conns.ClientSession).AtrackerV1()
+	atrackerClient, err := atrackerClientForRegion(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -8,7 +8,6 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -26,6 +25,12 @@ func ResourceIBMAtrackerRoute() *schema.Resource {
 		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The region where the route is managed. Defaults to the provider region.",
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -95,7 +100,7 @@ func ResourceIBMAtrackerRouteValidator() *validate.ResourceValidator {
 }
 
 func resourceIBMAtrackerRouteCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	atrackerClient, err := meta.(conns.ClientSession).AtrackerV1()
+	atrackerClient, err := atrackerClientForRegion(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -118,7 +123,7 @@ func resourceIBMAtrackerRouteCreate(context context.Context, d *schema.ResourceD
 		return diag.FromErr(fmt.Errorf("CreateRouteWithContext failed %s\n%s", err, response))
 	}
 
-	d.SetId(*route.ID)
+	d.SetId(atrackerRegionID(d.Get("region").(string), *route.ID))
 
 	return resourceIBMAtrackerRouteRead(context, d, meta)
 }
@@ -128,7 +133,9 @@ func resourceIBMAtrackerRouteMapToRule(ruleMap map[string]interface{}) atrackerv
 
 	targetIds := []string{}
 	for _, targetIdsItem := range ruleMap["target_ids"].([]interface{}) {
-		targetIds = append(targetIds, targetIdsItem.(string))
+		// Targets with a region argument have the region in their ID
+		_, targetID := atrackerParseRegionID(targetIdsItem.(string))
+		targetIds = append(targetIds, targetID)
 	}
 	rule.TargetIds = targetIds
 
@@ -136,14 +143,14 @@ func resourceIBMAtrackerRouteMapToRule(ruleMap map[string]interface{}) atrackerv
 }
 
 func resourceIBMAtrackerRouteRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	atrackerClient, err := meta.(conns.ClientSession).AtrackerV1()
+	atrackerClient, region, routeID, err := atrackerClientForID(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	getRouteOptions := &atrackerv1.GetRouteOptions{}
 
-	getRouteOptions.SetID(d.Id())
+	getRouteOptions.SetID(routeID)
 
 	route, response, err := atrackerClient.GetRouteWithContext(context, getRouteOptions)
 	if err != nil {
@@ -155,6 +162,9 @@ func resourceIBMAtrackerRouteRead(context context.Context, d *schema.ResourceDat
 		return diag.FromErr(fmt.Errorf("GetRouteWithContext failed %s\n%s", err, response))
 	}
 
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting region: %s", err))
+	}
 	if err = d.Set("name", route.Name); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
 	}
@@ -162,8 +172,8 @@ func resourceIBMAtrackerRouteRead(context context.Context, d *schema.ResourceDat
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting receive_global_events: %s", err))
 	}
 	rules := []map[string]interface{}{}
-	for _, rulesItem := range route.Rules {
-		rulesItemMap := resourceIBMAtrackerRouteRuleToMap(rulesItem)
+	for i, rulesItem := range route.Rules {
+		rulesItemMap := resourceIBMAtrackerRouteRuleToMap(rulesItem, d.Get(fmt.Sprintf("rules.%d.target_ids", i)).([]interface{}))
 		rules = append(rules, rulesItemMap)
 	}
 	if err = d.Set("rules", rules); err != nil {
@@ -185,23 +195,34 @@ func resourceIBMAtrackerRouteRead(context context.Context, d *schema.ResourceDat
 	return nil
 }
 
-func resourceIBMAtrackerRouteRuleToMap(rule atrackerv1.Rule) map[string]interface{} {
+// resourceIBMAtrackerRouteRuleToMap keeps the configured target IDs that only
+// differ from the target IDs of the rule by their region.
+func resourceIBMAtrackerRouteRuleToMap(rule atrackerv1.Rule, configured []interface{}) map[string]interface{} {
 	ruleMap := map[string]interface{}{}
 
-	ruleMap["target_ids"] = rule.TargetIds
+	targetIds := []string{}
+	for i, targetID := range rule.TargetIds {
+		if i < len(configured) {
+			if _, id := atrackerParseRegionID(configured[i].(string)); id == targetID {
+				targetID = configured[i].(string)
+			}
+		}
+		targetIds = append(targetIds, targetID)
+	}
+	ruleMap["target_ids"] = targetIds
 
 	return ruleMap
 }
 
 func resourceIBMAtrackerRouteUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	atrackerClient, err := meta.(conns.ClientSession).AtrackerV1()
+	atrackerClient, _, routeID, err := atrackerClientForID(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	replaceRouteOptions := &atrackerv1.ReplaceRouteOptions{}
 
-	replaceRouteOptions.SetID(d.Id())
+	replaceRouteOptions.SetID(routeID)
 	replaceRouteOptions.SetName(d.Get("name").(string))
 	replaceRouteOptions.SetReceiveGlobalEvents(d.Get("receive_global_events").(bool))
 	var rules []atrackerv1.Rule
@@ -222,14 +243,14 @@ func resourceIBMAtrackerRouteUpdate(context context.Context, d *schema.ResourceD
 }
 
 func resourceIBMAtrackerRouteDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	atrackerClient, err := meta.(conns.ClientSession).AtrackerV1()
+	atrackerClient, _, routeID, err := atrackerClientForID(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	deleteRouteOptions := &atrackerv1.DeleteRouteOptions{}
 
-	deleteRouteOptions.SetID(d.Id())
+	deleteRouteOptions.SetID(routeID)
 
 	response, err := atrackerClient.DeleteRouteWithContext(context, deleteRouteOptions)
 	if err != nil {
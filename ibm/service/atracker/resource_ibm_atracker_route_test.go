@@ -8,7 +8,6 @@ import (
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -87,14 +86,14 @@ func testAccCheckIBMAtrackerRouteExists(n string, obj atrackerv1.Route) resource
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		atrackerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).AtrackerV1()
+		atrackerClient, id, err := testAccAtrackerClientForID(rs.Primary.ID)
 		if err != nil {
 			return err
 		}
 
 		getRouteOptions := &atrackerv1.GetRouteOptions{}
 
-		getRouteOptions.SetID(rs.Primary.ID)
+		getRouteOptions.SetID(id)
 
 		route, _, err := atrackerClient.GetRoute(getRouteOptions)
 		if err != nil {
@@ -107,18 +106,19 @@ func testAccCheckIBMAtrackerRouteExists(n string, obj atrackerv1.Route) resource
 }
 
 func testAccCheckIBMAtrackerRouteDestroy(s *terraform.State) error {
-	atrackerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).AtrackerV1()
-	if err != nil {
-		return err
-	}
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ibm_atracker_route" {
 			continue
 		}
 
+		atrackerClient, id, err := testAccAtrackerClientForID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
 		getRouteOptions := &atrackerv1.GetRouteOptions{}
 
-		getRouteOptions.SetID(rs.Primary.ID)
+		getRouteOptions.SetID(id)
 
 		// Try to find the key
 		_, response, err := atrackerClient.GetRoute(getRouteOptions)
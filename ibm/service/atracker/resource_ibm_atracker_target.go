@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -27,6 +28,12 @@ func ResourceIBMAtrackerTarget() *schema.Resource {
 		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The region where the target is managed. Defaults to the provider region.",
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -73,6 +80,12 @@ func ResourceIBMAtrackerTarget() *schema.Resource {
 					},
 				},
 			},
+			"prevent_destroy_when_routed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to true, the target is not deleted while routes still send events to it. The deletion fails and lists those routes.",
+			},
 			"crn": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -147,7 +160,7 @@ func ResourceIBMAtrackerTargetValidator() *validate.ResourceValidator {
 }
 
 func resourceIBMAtrackerTargetCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	atrackerClient, err := meta.(conns.ClientSession).AtrackerV1()
+	atrackerClient, err := atrackerClientForRegion(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -165,7 +178,7 @@ func resourceIBMAtrackerTargetCreate(context context.Context, d *schema.Resource
 		return diag.FromErr(fmt.Errorf("CreateTargetWithContext failed %s\n%s", err, response))
 	}
 
-	d.SetId(*target.ID)
+	d.SetId(atrackerRegionID(d.Get("region").(string), *target.ID))
 
 	return resourceIBMAtrackerTargetRead(context, d, meta)
 }
@@ -182,14 +195,14 @@ func resourceIBMAtrackerTargetMapToCosEndpoint(cosEndpointMap map[string]interfa
 }
 
 func resourceIBMAtrackerTargetRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	atrackerClient, err := meta.(conns.ClientSession).AtrackerV1()
+	atrackerClient, region, targetID, err := atrackerClientForID(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	getTargetOptions := &atrackerv1.GetTargetOptions{}
 
-	getTargetOptions.SetID(d.Id())
+	getTargetOptions.SetID(targetID)
 
 	target, response, err := atrackerClient.GetTargetWithContext(context, getTargetOptions)
 	if err != nil {
@@ -201,6 +214,9 @@ func resourceIBMAtrackerTargetRead(context context.Context, d *schema.ResourceDa
 		return diag.FromErr(fmt.Errorf("GetTargetWithContext failed %s\n%s", err, response))
 	}
 
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting region: %s", err))
+	}
 	if err = d.Set("name", target.Name); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
 	}
@@ -261,14 +277,14 @@ func resourceIBMAtrackerTargetCosWriteStatusToMap(cosWriteStatus atrackerv1.CosW
 }
 
 func resourceIBMAtrackerTargetUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	atrackerClient, err := meta.(conns.ClientSession).AtrackerV1()
+	atrackerClient, _, targetID, err := atrackerClientForID(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	replaceTargetOptions := &atrackerv1.ReplaceTargetOptions{}
 
-	replaceTargetOptions.SetID(d.Id())
+	replaceTargetOptions.SetID(targetID)
 
 	hasChange := false
 
@@ -292,14 +308,26 @@ func resourceIBMAtrackerTargetUpdate(context context.Context, d *schema.Resource
 }
 
 func resourceIBMAtrackerTargetDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	atrackerClient, err := meta.(conns.ClientSession).AtrackerV1()
+	atrackerClient, _, targetID, err := atrackerClientForID(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if d.Get("prevent_destroy_when_routed").(bool) {
+		routes, err := atrackerRoutesForTarget(context, atrackerClient, targetID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(routes) > 0 {
+			return diag.FromErr(fmt.Errorf("[ERROR] Target %s is still referenced by the routes %s. "+
+				"Remove the target from these routes, or set prevent_destroy_when_routed to false, before deleting it",
+				d.Id(), strings.Join(routes, ", ")))
+		}
+	}
+
 	deleteTargetOptions := &atrackerv1.DeleteTargetOptions{}
 
-	deleteTargetOptions.SetID(d.Id())
+	deleteTargetOptions.SetID(targetID)
 
 	_, response, err := atrackerClient.DeleteTargetWithContext(context, deleteTargetOptions)
 	if err != nil {
@@ -311,3 +339,58 @@ func resourceIBMAtrackerTargetDelete(context context.Context, d *schema.Resource
 
 	return nil
 }
+
+// atrackerRoutesForTarget returns the names and IDs of the routes with a rule
+// that sends events to the given target.
+func atrackerRoutesForTarget(context context.Context, atrackerClient *atrackerv1.AtrackerV1, targetID string) ([]string, error) {
+	listRoutesOptions := &atrackerv1.ListRoutesOptions{}
+	routeList, response, err := atrackerClient.ListRoutesWithContext(context, listRoutesOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListRoutesWithContext failed %s\n%s", err, response)
+		return nil, fmt.Errorf("ListRoutesWithContext failed %s\n%s", err, response)
+	}
+	routes := []string{}
+	for _, route := range routeList.Routes {
+	rules:
+		for _, rule := range route.Rules {
+			for _, id := range rule.TargetIds {
+				if id == targetID {
+					routes = append(routes, fmt.Sprintf("%s (%s)", *route.Name, *route.ID))
+					break rules
+				}
+			}
+		}
+	}
+	return routes, nil
+}
+
+// atrackerClientForRegion returns the Activity Tracker client for the optional region argument.
+func atrackerClientForRegion(d *schema.ResourceData, meta interface{}) (*atrackerv1.AtrackerV1, error) {
+	return meta.(conns.ClientSession).AtrackerV1ForRegion(d.Get("region").(string))
+}
+
+// atrackerClientForID returns the Activity Tracker client for the region in the
+// ID of a target or route, along with that region and the Activity Tracker ID.
+func atrackerClientForID(d *schema.ResourceData, meta interface{}) (*atrackerv1.AtrackerV1, string, string, error) {
+	region, id := atrackerParseRegionID(d.Id())
+	atrackerClient, err := meta.(conns.ClientSession).AtrackerV1ForRegion(region)
+	return atrackerClient, region, id, err
+}
+
+// atrackerRegionID returns the Terraform ID of a target or route. The region is
+// kept in the ID when it is set, so that read and import use the same region.
+func atrackerRegionID(region, id string) string {
+	if region == "" {
+		return id
+	}
+	return region + "/" + id
+}
+
+// atrackerParseRegionID returns the region and the Activity Tracker ID of the
+// Terraform ID of a target or route, the region is empty for the provider region.
+func atrackerParseRegionID(id string) (string, string) {
+	if i := strings.Index(id, "/"); i >= 0 {
+		return id[:i], id[i+1:]
+	}
+	return "", id
+}
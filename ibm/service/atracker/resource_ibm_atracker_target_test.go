@@ -5,6 +5,8 @@ package atracker_test
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -48,11 +50,86 @@ func TestAccIBMAtrackerTargetBasic(t *testing.T) {
 				ResourceName:      "ibm_atracker_target.atracker_target",
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"prevent_destroy_when_routed",
+				},
 			},
 		},
 	})
 }
 
+func TestAccIBMAtrackerTargetPreventDestroyWhenRouted(t *testing.T) {
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMAtrackerTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMAtrackerTargetConfigPreventDestroy(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target", "prevent_destroy_when_routed", "true"),
+					resource.TestCheckResourceAttrPair("ibm_atracker_route.atracker_route", "rules.0.target_ids.0", "ibm_atracker_target.atracker_target", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMAtrackerTargetRegion(t *testing.T) {
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	region := "us-east"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMAtrackerTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMAtrackerTargetConfigRegion(name, region),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target", "name", name),
+					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target", "region", region),
+					resource.TestMatchResourceAttr("ibm_atracker_target.atracker_target", "id", regexp.MustCompile("^"+region+"/")),
+					resource.TestCheckResourceAttrSet("ibm_atracker_target.atracker_target", "crn"),
+					resource.TestCheckResourceAttr("data.ibm_atracker_targets.atracker_targets", "targets.0.name", name),
+				),
+			},
+			{
+				ResourceName:      "ibm_atracker_target.atracker_target",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"prevent_destroy_when_routed",
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMAtrackerTargetConfigRegion(name string, region string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_atracker_target" "atracker_target" {
+			name = "%s"
+			target_type = "cloud_object_storage"
+			region = "%s"
+			cos_endpoint {
+				endpoint = "s3.private.us-east.cloud-object-storage.appdomain.cloud"
+				target_crn = "crn:v1:bluemix:public:cloud-object-storage:global:a/11111111111111111111111111111111:22222222-2222-2222-2222-222222222222::"
+				bucket = "my-atracker-bucket"
+				api_key = "xxxxxxxxxxxxxx"
+			}
+		}
+
+		data "ibm_atracker_targets" "atracker_targets" {
+			name = ibm_atracker_target.atracker_target.name
+			region = ibm_atracker_target.atracker_target.region
+		}
+	`, name, region)
+}
+
 func testAccCheckIBMAtrackerTargetConfigBasic(name string, targetType string) string {
 	return fmt.Sprintf(`
 
@@ -77,14 +154,14 @@ func testAccCheckIBMAtrackerTargetExists(n string, obj atrackerv1.Target) resour
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		atrackerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).AtrackerV1()
+		atrackerClient, id, err := testAccAtrackerClientForID(rs.Primary.ID)
 		if err != nil {
 			return err
 		}
 
 		getTargetOptions := &atrackerv1.GetTargetOptions{}
 
-		getTargetOptions.SetID(rs.Primary.ID)
+		getTargetOptions.SetID(id)
 
 		target, _, err := atrackerClient.GetTarget(getTargetOptions)
 		if err != nil {
@@ -97,18 +174,19 @@ func testAccCheckIBMAtrackerTargetExists(n string, obj atrackerv1.Target) resour
 }
 
 func testAccCheckIBMAtrackerTargetDestroy(s *terraform.State) error {
-	atrackerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).AtrackerV1()
-	if err != nil {
-		return err
-	}
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ibm_atracker_target" {
 			continue
 		}
 
+		atrackerClient, id, err := testAccAtrackerClientForID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
 		getTargetOptions := &atrackerv1.GetTargetOptions{}
 
-		getTargetOptions.SetID(rs.Primary.ID)
+		getTargetOptions.SetID(id)
 
 		// Try to find the key
 		_, response, err := atrackerClient.GetTarget(getTargetOptions)
@@ -122,3 +200,39 @@ func testAccCheckIBMAtrackerTargetDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func testAccCheckIBMAtrackerTargetConfigPreventDestroy(name string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_atracker_target" "atracker_target" {
+			name = "%s"
+			target_type = "cloud_object_storage"
+			prevent_destroy_when_routed = true
+			cos_endpoint {
+				endpoint = "s3.private.us-east.cloud-object-storage.appdomain.cloud"
+				target_crn = "crn:v1:bluemix:public:cloud-object-storage:global:a/11111111111111111111111111111111:22222222-2222-2222-2222-222222222222::"
+				bucket = "my-atracker-bucket"
+				api_key = "xxxxxxxxxxxxxx"
+			}
+		}
+
+		resource "ibm_atracker_route" "atracker_route" {
+			name = "%s-route"
+			receive_global_events = false
+			rules {
+				target_ids = [ ibm_atracker_target.atracker_target.id ]
+			}
+		}
+	`, name, name)
+}
+
+// testAccAtrackerClientForID returns the Activity Tracker client for the region
+// in the ID of a target or route, and the Activity Tracker ID.
+func testAccAtrackerClientForID(id string) (*atrackerv1.AtrackerV1, string, error) {
+	region := ""
+	if parts := strings.SplitN(id, "/", 2); len(parts) == 2 {
+		region, id = parts[0], parts[1]
+	}
+	atrackerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).AtrackerV1ForRegion(region)
+	return atrackerClient, id, err
+}
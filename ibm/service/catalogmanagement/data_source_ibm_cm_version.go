@@ -5,6 +5,7 @@ package catalogmanagement
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 
@@ -61,6 +62,100 @@ func DataSourceIBMCmVersion() *schema.Resource {
 				Computed:    true,
 				Description: "File used to on-board this version.",
 			},
+			"long_description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Long description for version.",
+			},
+			"readme": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The readme of the version, in markdown.",
+			},
+			"licenses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of license agreements.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "License ID.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "license name.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "type of license e.g., Apache xxx.",
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "URL for the license text.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "License description.",
+						},
+					},
+				},
+			},
+			"configuration": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of user solicited overrides, the input variables of the version.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Configuration key.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Value type (string, boolean, int).",
+						},
+						"default_value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The default value, JSON encoded unless it is a string.",
+						},
+						"value_constraint": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Constraint associated with value, e.g., for string type - regx:[a-z].",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Key description.",
+						},
+						"required": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Is key required to install.",
+						},
+						"options": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "List of options of type, JSON encoded unless they are strings.",
+						},
+						"hidden": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Hide values.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -76,12 +171,14 @@ func dataSourceIBMCmVersionRead(context context.Context, d *schema.ResourceData,
 	getVersionOptions.SetVersionLocID(d.Get("version_loc_id").(string))
 
 	offering, response, err := catalogManagementClient.GetVersionWithContext(context, getVersionOptions)
-	version := offering.Kinds[0].Versions[0]
-
 	if err != nil {
 		log.Printf("[DEBUG] GetVersionWithContext failed %s\n%s", err, response)
 		return diag.FromErr(err)
 	}
+	if len(offering.Kinds) == 0 || len(offering.Kinds[0].Versions) == 0 {
+		return diag.FromErr(fmt.Errorf("[ERROR] No version found with version_loc_id %s", d.Get("version_loc_id").(string)))
+	}
+	version := offering.Kinds[0].Versions[0]
 
 	d.SetId(*version.VersionLocator)
 	if err = d.Set("crn", version.CRN); err != nil {
@@ -105,6 +202,111 @@ func dataSourceIBMCmVersionRead(context context.Context, d *schema.ResourceData,
 	if err = d.Set("tgz_url", version.TgzURL); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting tgz_url: %s", err))
 	}
+	if err = d.Set("long_description", version.LongDescription); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting long_description: %s", err))
+	}
+	getOfferingAboutOptions := &catalogmanagementv1.GetOfferingAboutOptions{}
+	getOfferingAboutOptions.SetVersionLocID(d.Get("version_loc_id").(string))
+	readme, response, err := catalogManagementClient.GetOfferingAboutWithContext(context, getOfferingAboutOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] GetOfferingAboutWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting readme of version %s: %s\n%s", d.Get("version_loc_id").(string), err, response))
+	}
+	if err = d.Set("readme", readme); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting readme: %s", err))
+	}
+	licenses := []map[string]interface{}{}
+	for _, license := range version.Licenses {
+		licenses = append(licenses, dataSourceIBMCmVersionLicenseToMap(license))
+	}
+	if err = d.Set("licenses", licenses); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting licenses: %s", err))
+	}
+	configuration := []map[string]interface{}{}
+	for _, config := range version.Configuration {
+		configMap, err := dataSourceIBMCmVersionConfigurationToMap(config)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting configuration: %s", err))
+		}
+		configuration = append(configuration, configMap)
+	}
+	if err = d.Set("configuration", configuration); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting configuration: %s", err))
+	}
 
 	return nil
 }
+
+func dataSourceIBMCmVersionLicenseToMap(license catalogmanagementv1.License) map[string]interface{} {
+	licenseMap := map[string]interface{}{}
+	if license.ID != nil {
+		licenseMap["id"] = license.ID
+	}
+	if license.Name != nil {
+		licenseMap["name"] = license.Name
+	}
+	if license.Type != nil {
+		licenseMap["type"] = license.Type
+	}
+	if license.URL != nil {
+		licenseMap["url"] = license.URL
+	}
+	if license.Description != nil {
+		licenseMap["description"] = license.Description
+	}
+	return licenseMap
+}
+
+func dataSourceIBMCmVersionConfigurationToMap(config catalogmanagementv1.Configuration) (map[string]interface{}, error) {
+	configMap := map[string]interface{}{}
+	if config.Key != nil {
+		configMap["key"] = config.Key
+	}
+	if config.Type != nil {
+		configMap["type"] = config.Type
+	}
+	if config.DefaultValue != nil {
+		defaultValue, err := cmVersionConfigurationValueToString(config.DefaultValue)
+		if err != nil {
+			return nil, err
+		}
+		configMap["default_value"] = defaultValue
+	}
+	if config.ValueConstraint != nil {
+		configMap["value_constraint"] = config.ValueConstraint
+	}
+	if config.Description != nil {
+		configMap["description"] = config.Description
+	}
+	if config.Required != nil {
+		configMap["required"] = config.Required
+	}
+	if config.Options != nil {
+		options := []string{}
+		for _, option := range config.Options {
+			value, err := cmVersionConfigurationValueToString(option)
+			if err != nil {
+				return nil, err
+			}
+			options = append(options, value)
+		}
+		configMap["options"] = options
+	}
+	if config.Hidden != nil {
+		configMap["hidden"] = config.Hidden
+	}
+	return configMap, nil
+}
+
+// cmVersionConfigurationValueToString returns a string value as is, and
+// the other values JSON encoded.
+func cmVersionConfigurationValueToString(value interface{}) (string, error) {
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
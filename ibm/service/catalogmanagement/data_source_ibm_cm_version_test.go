@@ -22,6 +22,9 @@ func TestAccIBMCmVersionDataSource(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.ibm_cm_version.cm_version_data", "crn"),
 					resource.TestCheckResourceAttrSet("data.ibm_cm_version.cm_version_data", "repo_url"),
+					resource.TestCheckResourceAttrSet("data.ibm_cm_version.cm_version_data", "configuration.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_cm_version.cm_version_data", "licenses.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_cm_version.cm_version_data", "readme"),
 				),
 			},
 		},
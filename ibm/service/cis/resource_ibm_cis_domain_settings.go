@@ -4,18 +4,23 @@
 package cis
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/zonessettingsv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 const (
 	ibmCISDomainSettings                             = "ibm_cis_domain_settings"
 	cisDomainSettingsDNSSEC                          = "dnssec"
+	cisDomainSettingsDNSSECDsRecord                  = "dnssec_ds_record"
 	cisDomainSettingsWAF                             = "waf"
 	cisDomainSettingsSSL                             = "ssl"
 	cisDomainSettingsCertificateStatus               = "certificate_status"
@@ -57,6 +62,7 @@ const (
 	cisDomainSettingsMobileRedirectStripURI          = "strip_uri"
 	cisDomainSettingsMaxUpload                       = "max_upload"
 	cisDomainSettingsCipher                          = "cipher"
+	cisDomainSettingsManagedSettings                 = "managed_settings"
 	cisDomainSettingsONOFFValidatorID                = "on_off"
 	cisDomainSettingsActiveDisableValidatorID        = "active_disable"
 	cisDomainSettingsSSLSettingValidatorID           = "ssl_setting"
@@ -67,6 +73,7 @@ const (
 	cisDomainSettingsChallengeTTLValidatorID         = "challenge_ttl"
 	cisDomainSettingsMaxUploadValidatorID            = "max_upload"
 	cisDomainSettingsCipherValidatorID               = "cipher"
+	cisDomainSettingsManagedSettingsValidatorID      = "managed_settings"
 )
 
 func ResourceIBMCISSettings() *schema.Resource {
@@ -83,6 +90,17 @@ func ResourceIBMCISSettings() *schema.Resource {
 				Required:         true,
 				DiffSuppressFunc: suppressDomainIDDiff,
 			},
+			cisDomainSettingsManagedSettings: {
+				Type:        schema.TypeSet,
+				Description: "The settings managed by this resource, all the settings if not set",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validate.InvokeValidator(
+						ibmCISDomainSettings,
+						cisDomainSettingsManagedSettingsValidatorID),
+				},
+			},
 			cisDomainSettingsDNSSEC: {
 				Type:        schema.TypeString,
 				Description: "DNS Sec setting",
@@ -92,6 +110,60 @@ func ResourceIBMCISSettings() *schema.Resource {
 					ibmCISDomainSettings,
 					cisDomainSettingsActiveDisableValidatorID),
 			},
+			cisDomainSettingsDNSSECDsRecord: {
+				Type:        schema.TypeList,
+				Description: "The DS record to add at the registrar of the domain once DNS Sec is enabled",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ds": {
+							Type:        schema.TypeString,
+							Description: "The full DS record",
+							Computed:    true,
+						},
+						"digest": {
+							Type:        schema.TypeString,
+							Description: "The digest of the DS record",
+							Computed:    true,
+						},
+						"digest_type": {
+							Type:        schema.TypeString,
+							Description: "The digest type of the DS record",
+							Computed:    true,
+						},
+						"digest_algorithm": {
+							Type:        schema.TypeString,
+							Description: "The digest algorithm of the DS record",
+							Computed:    true,
+						},
+						"algorithm": {
+							Type:        schema.TypeString,
+							Description: "The algorithm of the DNSKEY record",
+							Computed:    true,
+						},
+						"key_tag": {
+							Type:        schema.TypeInt,
+							Description: "The key tag of the DNSKEY record",
+							Computed:    true,
+						},
+						"key_type": {
+							Type:        schema.TypeString,
+							Description: "The key type of the DNSKEY record",
+							Computed:    true,
+						},
+						"flags": {
+							Type:        schema.TypeInt,
+							Description: "The flags of the DNSKEY record",
+							Computed:    true,
+						},
+						"public_key": {
+							Type:        schema.TypeString,
+							Description: "The public key of the DNSKEY record",
+							Computed:    true,
+						},
+					},
+				},
+			},
 			cisDomainSettingsWAF: {
 				Type:        schema.TypeString,
 				Description: "WAF setting",
@@ -446,6 +518,8 @@ func ResourceIBMCISSettings() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: resourceCISSettingsManagedSettingsDiff,
+
 		Create:   resourceCISSettingsUpdate,
 		Read:     resourceCISSettingsRead,
 		Update:   resourceCISSettingsUpdate,
@@ -454,6 +528,33 @@ func ResourceIBMCISSettings() *schema.Resource {
 	}
 }
 
+// resourceCISSettingsManagedSettingsDiff rejects the configured settings that
+// are not listed in managed_settings, since they would never be applied.
+func resourceCISSettingsManagedSettingsDiff(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	managed := d.Get(cisDomainSettingsManagedSettings).(*schema.Set)
+	config := d.GetRawConfig()
+	if managed.Len() == 0 || config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+	for _, item := range settingsList {
+		if managed.Contains(item) {
+			continue
+		}
+		if v := config.GetAttr(item); !v.IsNull() {
+			return fmt.Errorf("[ERROR] %s is configured but not listed in %s", item, cisDomainSettingsManagedSettings)
+		}
+	}
+	return nil
+}
+
+// cisDomainSettingManaged reports whether the setting is managed by the
+// resource, which is the case of all the settings when managed_settings is
+// not set.
+func cisDomainSettingManaged(d *schema.ResourceData, item string) bool {
+	managed := d.Get(cisDomainSettingsManagedSettings).(*schema.Set)
+	return managed.Len() == 0 || managed.Contains(item)
+}
+
 func ResourceIBMCISDomainSettingValidator() *validate.ResourceValidator {
 
 	sslSetting := "off, flexible, full, strict, origin_pull"
@@ -545,6 +646,13 @@ func ResourceIBMCISDomainSettingValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeString,
 			Required:                   true,
 			AllowedValues:              cipher})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisDomainSettingsManagedSettingsValidatorID,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              strings.Join(settingsList, ", ")})
 	ibmCISDomainSettingResourceValidator := validate.ResourceValidator{
 		ResourceName: ibmCISDomainSettings,
 		Schema:       validateSchema}
@@ -600,6 +708,10 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 		var err error
 		var resp *core.DetailedResponse
 
+		if !cisDomainSettingManaged(d, item) {
+			continue
+		}
+
 		switch item {
 		case cisDomainSettingsDNSSEC:
 			if d.HasChange(item) {
@@ -918,12 +1030,24 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 	for _, item := range settingsList {
 		var settingErr error
 		var settingResponse *core.DetailedResponse
+
+		// Clear the settings managed elsewhere, so that their changes do not
+		// show up as drift of this resource.
+		if !cisDomainSettingManaged(d, item) {
+			d.Set(item, nil)
+			if item == cisDomainSettingsDNSSEC {
+				d.Set(cisDomainSettingsDNSSECDsRecord, nil)
+			}
+			continue
+		}
+
 		switch item {
 		case cisDomainSettingsDNSSEC:
 			opt := cisClient.NewGetZoneDnssecOptions()
 			result, resp, err := cisClient.GetZoneDnssec(opt)
 			if err == nil {
 				d.Set(cisDomainSettingsDNSSEC, result.Result.Status)
+				d.Set(cisDomainSettingsDNSSECDsRecord, flattenCISDomainSettingsDNSSECDsRecord(result.Result))
 			}
 			settingResponse = resp
 			settingErr = err
@@ -1262,3 +1386,37 @@ func resourceCISSettingsDelete(d *schema.ResourceData, meta interface{}) error {
 	d.SetId("")
 	return nil
 }
+
+func flattenCISDomainSettingsDNSSECDsRecord(result *zonessettingsv1.ZonesDnssecRespResult) []map[string]interface{} {
+	if result == nil || result.Ds == nil {
+		return []map[string]interface{}{}
+	}
+	record := map[string]interface{}{
+		"ds": *result.Ds,
+	}
+	if result.Digest != nil {
+		record["digest"] = *result.Digest
+	}
+	if result.DigestType != nil {
+		record["digest_type"] = *result.DigestType
+	}
+	if result.DigestAlgorithm != nil {
+		record["digest_algorithm"] = *result.DigestAlgorithm
+	}
+	if result.Algorithm != nil {
+		record["algorithm"] = *result.Algorithm
+	}
+	if result.KeyTag != nil {
+		record["key_tag"] = *result.KeyTag
+	}
+	if result.KeyType != nil {
+		record["key_type"] = *result.KeyType
+	}
+	if result.Flags != nil {
+		record["flags"] = *result.Flags
+	}
+	if result.PublicKey != nil {
+		record["public_key"] = *result.PublicKey
+	}
+	return []map[string]interface{}{record}
+}
@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/zonessettingsv1"
+)
+
+func TestFlattenCISDomainSettingsDNSSECDsRecord(t *testing.T) {
+	result := &zonessettingsv1.ZonesDnssecRespResult{
+		Status:          core.StringPtr("active"),
+		Flags:           core.Int64Ptr(257),
+		Algorithm:       core.StringPtr("13"),
+		KeyType:         core.StringPtr("ECDSAP256SHA256"),
+		DigestType:      core.StringPtr("2"),
+		DigestAlgorithm: core.StringPtr("SHA256"),
+		Digest:          core.StringPtr("48E939042E82C22542CB377B580DFDC52A361CEFDC72E7F9107E2B6BD9306A45"),
+		Ds:              core.StringPtr("example.com. 3600 IN DS 16953 13 2 48E939042E82C22542CB377B580DFDC52A361CEFDC72E7F9107E2B6BD9306A45"),
+		KeyTag:          core.Int64Ptr(16953),
+		PublicKey:       core.StringPtr("oXiGYrSTO+LSCJ3mohc8EP+CzF9KxBj8/ydXJ22pKuZP3VAC3/Md/k7xZfz470CoRyZJ6gV6vml07IC3d8xqhA=="),
+	}
+	want := []map[string]interface{}{{
+		"ds":               "example.com. 3600 IN DS 16953 13 2 48E939042E82C22542CB377B580DFDC52A361CEFDC72E7F9107E2B6BD9306A45",
+		"digest":           "48E939042E82C22542CB377B580DFDC52A361CEFDC72E7F9107E2B6BD9306A45",
+		"digest_type":      "2",
+		"digest_algorithm": "SHA256",
+		"algorithm":        "13",
+		"key_tag":          int64(16953),
+		"key_type":         "ECDSAP256SHA256",
+		"flags":            int64(257),
+		"public_key":       "oXiGYrSTO+LSCJ3mohc8EP+CzF9KxBj8/ydXJ22pKuZP3VAC3/Md/k7xZfz470CoRyZJ6gV6vml07IC3d8xqhA==",
+	}}
+	if got := flattenCISDomainSettingsDNSSECDsRecord(result); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	disabled := &zonessettingsv1.ZonesDnssecRespResult{Status: core.StringPtr("disabled")}
+	if got := flattenCISDomainSettingsDNSSECDsRecord(disabled); len(got) != 0 {
+		t.Errorf("got %v for a disabled DNSSEC, want no record", got)
+	}
+}
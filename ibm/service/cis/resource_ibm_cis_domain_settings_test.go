@@ -5,6 +5,7 @@ package cis_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -53,6 +54,37 @@ func TestAccIBMCisSettings_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMCisSettings_DNSSEC(t *testing.T) {
+	name := "ibm_cis_domain_settings." + "test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisSettingsConfigDNSSEC("test", "active"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "dnssec_ds_record.#", "1"),
+					resource.TestMatchResourceAttr(name, "dnssec_ds_record.0.ds",
+						regexp.MustCompile(`^`+regexp.QuoteMeta(acc.CisDomainStatic)+`\. 3600 IN DS [0-9]+ 13 2 [0-9A-F]+$`)),
+					resource.TestCheckResourceAttr(name, "dnssec_ds_record.0.algorithm", "13"),
+					resource.TestCheckResourceAttr(name, "dnssec_ds_record.0.digest_type", "2"),
+					resource.TestCheckResourceAttr(name, "dnssec_ds_record.0.digest_algorithm", "SHA256"),
+					resource.TestCheckResourceAttr(name, "dnssec_ds_record.0.key_type", "ECDSAP256SHA256"),
+					resource.TestCheckResourceAttr(name, "dnssec_ds_record.0.flags", "257"),
+				),
+			},
+			{
+				Config: testAccCheckCisSettingsConfigDNSSEC("test", "disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "dnssec", "disabled"),
+					resource.TestCheckResourceAttr(name, "dnssec_ds_record.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMCisSettings_Import(t *testing.T) {
 	name := "ibm_cis_domain_settings." + "test"
 
@@ -76,6 +108,59 @@ func TestAccIBMCisSettings_Import(t *testing.T) {
 	})
 }
 
+func TestAccIBMCisSettings_ManagedSettings(t *testing.T) {
+	waf := "ibm_cis_domain_settings.waf"
+	tls := "ibm_cis_domain_settings.tls"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisSettingsConfigManagedSettings(acc.CisDomainStatic),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(waf, "managed_settings.#", "1"),
+					resource.TestCheckResourceAttr(waf, "waf", "off"),
+					resource.TestCheckResourceAttr(waf, "min_tls_version", ""),
+					resource.TestCheckResourceAttr(tls, "managed_settings.#", "2"),
+					resource.TestCheckResourceAttr(tls, "ssl", "full"),
+					resource.TestCheckResourceAttr(tls, "min_tls_version", "1.2"),
+					resource.TestCheckResourceAttr(tls, "waf", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCisSettingsConfigManagedSettings(CisDomainStatic string) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + `
+	resource "ibm_cis_domain_settings" "waf" {
+		cis_id           = data.ibm_cis.cis.id
+		domain_id        = data.ibm_cis_domain.cis_domain.id
+		managed_settings = ["waf"]
+		waf              = "off"
+	  }
+
+	resource "ibm_cis_domain_settings" "tls" {
+		cis_id           = data.ibm_cis.cis.id
+		domain_id        = data.ibm_cis_domain.cis_domain.id
+		managed_settings = ["ssl", "min_tls_version"]
+		ssl              = "full"
+		min_tls_version  = "1.2"
+	  }
+`
+}
+
+func testAccCheckCisSettingsConfigDNSSEC(id string, dnssec string) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_domain_settings" "%[1]s" {
+		cis_id    = data.ibm_cis.cis.id
+		domain_id = data.ibm_cis_domain.cis_domain.id
+		dnssec    = "%[2]s"
+	  }
+`, id, dnssec)
+}
+
 func testAccCheckCisSettingsConfigBasic3(id string, CisDomainStatic string) string {
 	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
 	resource "ibm_cis_domain_settings" "%[1]s" {
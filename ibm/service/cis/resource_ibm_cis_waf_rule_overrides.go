@@ -0,0 +1,378 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/wafrulesapiv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	ibmCISWAFRuleOverrides          = "ibm_cis_waf_rule_overrides"
+	cisWAFRuleOverride              = "override"
+	cisWAFRuleOverrideRuleIDs       = "rule_ids"
+	cisWAFRuleOverrideGroups        = "groups"
+	cisWAFRuleOverrideRules         = "rules"
+	cisWAFRuleOverrideGroupID       = "group_id"
+	cisWAFRuleOverrideGroupName     = "group_name"
+	cisWAFRuleModeDefault           = "default"
+	cisWAFRuleOverridesRulesPerPage = 1000
+)
+
+func ResourceIBMCISWAFRuleOverrides() *schema.Resource {
+	return &schema.Resource{
+		Create: ResourceIBMCISWAFRuleOverridesUpdate,
+		Read:   ResourceIBMCISWAFRuleOverridesRead,
+		Update: ResourceIBMCISWAFRuleOverridesUpdate,
+		Delete: ResourceIBMCISWAFRuleOverridesDelete,
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMCISWAFRuleOverridesCustomizeDiff(diff)
+			},
+		),
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "CIS Intance CRN",
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "CIS Domain ID",
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisWAFRulePackageID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "CIS WAF Rule package id",
+			},
+			cisWAFRuleOverride: {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The modes of the WAF rules, by rule ID or by group. A rule that is selected by its ID takes precedence over its group",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						cisWAFRuleMode: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "CIS WAF Rule mode",
+							ValidateFunc: validate.InvokeValidator(ibmCISWAFRuleOverrides, cisWAFRuleMode),
+						},
+						cisWAFRuleOverrideRuleIDs: {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The IDs of the WAF rules",
+						},
+						cisWAFRuleOverrideGroups: {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The IDs or names of the WAF rule groups, whose rules are all set to the mode",
+						},
+					},
+				},
+			},
+			cisWAFRuleOverrideRules: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The WAF rules selected by the overrides, with their current mode",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						cisWAFRuleID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "CIS WAF Rule id",
+						},
+						cisWAFRuleOverrideGroupID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "waf rule group id",
+						},
+						cisWAFRuleOverrideGroupName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "waf rule group name",
+						},
+						cisWAFRuleMode: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "CIS WAF Rule mode",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMCISWAFRuleOverridesValidator() *validate.ResourceValidator {
+
+	validateSchema := make([]validate.ValidateSchema, 0)
+	modes := "on, off, default, disable, simulate, block, challenge"
+
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisWAFRuleMode,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              modes})
+	ibmCISWAFRuleOverridesValidator := validate.ResourceValidator{ResourceName: ibmCISWAFRuleOverrides, Schema: validateSchema}
+	return &ibmCISWAFRuleOverridesValidator
+}
+
+func ResourceIBMCISWAFRuleOverridesUpdate(d *schema.ResourceData, meta interface{}) error {
+	cisClient, err := meta.(conns.ClientSession).CisWAFRuleClientSession()
+	if err != nil {
+		return err
+	}
+
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	cisClient.Crn = core.StringPtr(crn)
+	cisClient.ZoneID = core.StringPtr(zoneID)
+	packageID, _, _, _ := flex.ConvertTfToCisThreeVar(d.Get(cisWAFRulePackageID).(string))
+
+	rules, err := listCISWAFRules(cisClient, packageID)
+	if err != nil {
+		return err
+	}
+	overrides := d.Get(cisWAFRuleOverride).([]interface{})
+
+	// The rules that were selected before, but are not anymore, are set back
+	// to their default mode
+	previous := map[string]bool{}
+	oldRules, _ := d.GetChange(cisWAFRuleOverrideRules)
+	for _, r := range oldRules.([]interface{}) {
+		previous[r.(map[string]interface{})[cisWAFRuleID].(string)] = true
+	}
+
+	for _, rule := range rules {
+		groupID, groupName := cisWAFRuleOverrideGroup(rule)
+		mode, selected := cisWAFRuleOverrideMode(overrides, *rule.ID, groupID, groupName)
+		if !selected {
+			if !previous[*rule.ID] {
+				continue
+			}
+			mode = cisWAFRuleDefaultMode(rule)
+		}
+		if *rule.Mode == mode {
+			continue
+		}
+		if !cisWAFRuleModeAllowed(rule, mode) {
+			return fmt.Errorf("[ERROR] Mode %s is not allowed for WAF rule %s, allowed modes are %v", mode, *rule.ID, rule.AllowedModes)
+		}
+		if err := updateCISWAFRuleMode(cisClient, packageID, rule, mode); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(flex.ConvertCisToTfThreeVar(packageID, zoneID, crn))
+	return ResourceIBMCISWAFRuleOverridesRead(d, meta)
+}
+
+func ResourceIBMCISWAFRuleOverridesRead(d *schema.ResourceData, meta interface{}) error {
+	cisClient, err := meta.(conns.ClientSession).CisWAFRuleClientSession()
+	if err != nil {
+		return err
+	}
+	packageID, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
+	cisClient.Crn = core.StringPtr(crn)
+	cisClient.ZoneID = core.StringPtr(zoneID)
+
+	rules, err := listCISWAFRules(cisClient, packageID)
+	if err != nil {
+		return err
+	}
+	overrides := d.Get(cisWAFRuleOverride).([]interface{})
+	selected := []interface{}{}
+	for _, rule := range rules {
+		groupID, groupName := cisWAFRuleOverrideGroup(rule)
+		if _, ok := cisWAFRuleOverrideMode(overrides, *rule.ID, groupID, groupName); !ok {
+			continue
+		}
+		selected = append(selected, map[string]interface{}{
+			cisWAFRuleID:                *rule.ID,
+			cisWAFRuleOverrideGroupID:   groupID,
+			cisWAFRuleOverrideGroupName: groupName,
+			cisWAFRuleMode:              *rule.Mode,
+		})
+	}
+
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisWAFRulePackageID, packageID)
+	d.Set(cisWAFRuleOverrideRules, selected)
+	return nil
+}
+
+func ResourceIBMCISWAFRuleOverridesDelete(d *schema.ResourceData, meta interface{}) error {
+	cisClient, err := meta.(conns.ClientSession).CisWAFRuleClientSession()
+	if err != nil {
+		return err
+	}
+	packageID, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
+	cisClient.Crn = core.StringPtr(crn)
+	cisClient.ZoneID = core.StringPtr(zoneID)
+
+	rules, err := listCISWAFRules(cisClient, packageID)
+	if err != nil {
+		return err
+	}
+	overrides := d.Get(cisWAFRuleOverride).([]interface{})
+	for _, rule := range rules {
+		groupID, groupName := cisWAFRuleOverrideGroup(rule)
+		if _, ok := cisWAFRuleOverrideMode(overrides, *rule.ID, groupID, groupName); !ok {
+			continue
+		}
+		mode := cisWAFRuleDefaultMode(rule)
+		if *rule.Mode == mode {
+			continue
+		}
+		if err := updateCISWAFRuleMode(cisClient, packageID, rule, mode); err != nil {
+			return err
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMCISWAFRuleOverridesCustomizeDiff rejects the overrides that
+// select no rule, and plans an update when the mode of a selected rule was
+// changed outside of Terraform.
+func resourceIBMCISWAFRuleOverridesCustomizeDiff(diff *schema.ResourceDiff) error {
+	overrides := diff.Get(cisWAFRuleOverride).([]interface{})
+	for i, o := range overrides {
+		ruleIDsKey := fmt.Sprintf("%s.%d.%s", cisWAFRuleOverride, i, cisWAFRuleOverrideRuleIDs)
+		groupsKey := fmt.Sprintf("%s.%d.%s", cisWAFRuleOverride, i, cisWAFRuleOverrideGroups)
+		if !diff.NewValueKnown(ruleIDsKey) || !diff.NewValueKnown(groupsKey) {
+			continue
+		}
+		override, _ := o.(map[string]interface{})
+		ruleIDs, _ := override[cisWAFRuleOverrideRuleIDs].(*schema.Set)
+		groups, _ := override[cisWAFRuleOverrideGroups].(*schema.Set)
+		if (ruleIDs == nil || ruleIDs.Len() == 0) && (groups == nil || groups.Len() == 0) {
+			return fmt.Errorf("[ERROR] %s %d must set at least one of %s and %s", cisWAFRuleOverride, i, cisWAFRuleOverrideRuleIDs, cisWAFRuleOverrideGroups)
+		}
+	}
+
+	if diff.Id() == "" || diff.HasChange(cisWAFRuleOverride) {
+		return nil
+	}
+	for _, r := range diff.Get(cisWAFRuleOverrideRules).([]interface{}) {
+		rule := r.(map[string]interface{})
+		mode, ok := cisWAFRuleOverrideMode(overrides, rule[cisWAFRuleID].(string), rule[cisWAFRuleOverrideGroupID].(string), rule[cisWAFRuleOverrideGroupName].(string))
+		if ok && mode != rule[cisWAFRuleMode].(string) {
+			return diff.SetNewComputed(cisWAFRuleOverrideRules)
+		}
+	}
+	return nil
+}
+
+// cisWAFRuleOverrideMode returns the mode that the overrides set for the rule,
+// and whether the rule is selected by any of them.
+func cisWAFRuleOverrideMode(overrides []interface{}, ruleID, groupID, groupName string) (string, bool) {
+	groupMode, groupSelected := "", false
+	for _, o := range overrides {
+		if o == nil {
+			continue
+		}
+		override := o.(map[string]interface{})
+		mode := override[cisWAFRuleMode].(string)
+		if ruleIDs, ok := override[cisWAFRuleOverrideRuleIDs].(*schema.Set); ok && ruleIDs.Contains(ruleID) {
+			return mode, true
+		}
+		if groups, ok := override[cisWAFRuleOverrideGroups].(*schema.Set); ok && (groups.Contains(groupID) || groups.Contains(groupName)) {
+			groupMode, groupSelected = mode, true
+		}
+	}
+	return groupMode, groupSelected
+}
+
+// cisWAFRuleOverrideGroup returns the ID and the name of the group of the
+// rule, empty for a rule without a group.
+func cisWAFRuleOverrideGroup(rule wafrulesapiv1.WafRulesResponseResultItem) (string, string) {
+	if rule.Group == nil {
+		return "", ""
+	}
+	groupID, groupName := "", ""
+	if rule.Group.ID != nil {
+		groupID = *rule.Group.ID
+	}
+	if rule.Group.Name != nil {
+		groupName = *rule.Group.Name
+	}
+	return groupID, groupName
+}
+
+// cisWAFRuleDefaultMode returns the mode of the rule when it is not
+// overridden, on for the OWASP rules and default for the others.
+func cisWAFRuleDefaultMode(rule wafrulesapiv1.WafRulesResponseResultItem) string {
+	if cisWAFRuleModeAllowed(rule, cisWAFRuleModeDefault) {
+		return cisWAFRuleModeDefault
+	}
+	return cisWAFRuleModeOn
+}
+
+func cisWAFRuleModeAllowed(rule wafrulesapiv1.WafRulesResponseResultItem, mode string) bool {
+	for _, m := range rule.AllowedModes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
+func listCISWAFRules(cisClient *wafrulesapiv1.WafRulesApiV1, packageID string) ([]wafrulesapiv1.WafRulesResponseResultItem, error) {
+	rules := []wafrulesapiv1.WafRulesResponseResultItem{}
+	for page := int64(1); ; page++ {
+		opt := cisClient.NewListWafRulesOptions(packageID)
+		opt.SetPage(page)
+		opt.SetPerPage(cisWAFRuleOverridesRulesPerPage)
+		result, response, err := cisClient.ListWafRules(opt)
+		if err != nil {
+			log.Printf("List waf rules failed %s\n", response)
+			return nil, err
+		}
+		rules = append(rules, result.Result...)
+		if len(result.Result) < cisWAFRuleOverridesRulesPerPage || result.ResultInfo == nil || result.ResultInfo.TotalCount == nil || int64(len(rules)) >= *result.ResultInfo.TotalCount {
+			break
+		}
+	}
+	return rules, nil
+}
+
+func updateCISWAFRuleMode(cisClient *wafrulesapiv1.WafRulesApiV1, packageID string, rule wafrulesapiv1.WafRulesResponseResultItem, mode string) error {
+	updateOpt := cisClient.NewUpdateWafRuleOptions(packageID, *rule.ID)
+
+	// Mode differs based on OWASP and CIS
+	if *rule.Mode == cisWAFRuleModeOn || *rule.Mode == cisWAFRuleModeOff {
+		owaspOpt, _ := cisClient.NewWafRuleBodyOwasp(mode)
+		updateOpt.SetOwasp(owaspOpt)
+	} else {
+		cisOpt, _ := cisClient.NewWafRuleBodyCis(mode)
+		updateOpt.SetCis(cisOpt)
+	}
+	_, response, err := cisClient.UpdateWafRule(updateOpt)
+	if err != nil {
+		log.Printf("Update WAF rule %s setting failed: %v", *rule.ID, response)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceIBMCISWAFRuleOverridesCustomizeDiff(t *testing.T) {
+	override := func(o map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			cisID:               "crn:v1:bluemix:public:internet-svcs:global:a/account:instance::",
+			cisDomainID:         "domain",
+			cisWAFRulePackageID: "package",
+			cisWAFRuleOverride:  []interface{}{o},
+		}
+	}
+	cases := []struct {
+		name    string
+		raw     map[string]interface{}
+		wantErr bool
+	}{
+		{"rule ids", override(map[string]interface{}{cisWAFRuleMode: "on", cisWAFRuleOverrideRuleIDs: []interface{}{"100000"}}), false},
+		{"groups", override(map[string]interface{}{cisWAFRuleMode: "on", cisWAFRuleOverrideGroups: []interface{}{"Cloudflare Specials"}}), false},
+		{"no rule ids and groups", override(map[string]interface{}{cisWAFRuleMode: "on"}), true},
+		{"empty rule ids and groups", override(map[string]interface{}{cisWAFRuleMode: "on", cisWAFRuleOverrideRuleIDs: []interface{}{}, cisWAFRuleOverrideGroups: []interface{}{}}), true},
+	}
+	for _, c := range cases {
+		_, err := ResourceIBMCISWAFRuleOverrides().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(c.raw), nil)
+		switch {
+		case !c.wantErr && err != nil:
+			t.Errorf("%s: unexpected error %s", c.name, err)
+		case c.wantErr && (err == nil || !strings.Contains(err.Error(), "must set at least one of")):
+			t.Errorf("%s: got error %v, want a missing rule_ids and groups error", c.name, err)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisWAFRuleOverrides_Basic(t *testing.T) {
+	name := "ibm_cis_waf_rule_overrides." + "test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisWAFRuleOverridesConfigBasic("simulate"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "override.#", "2"),
+					resource.TestCheckResourceAttrSet(name, "rules.#"),
+				),
+			},
+			{
+				Config: testAccCheckCisWAFRuleOverridesConfigBasic("block"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "override.1.mode", "block"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCisWAFRuleOverridesConfigBasic(mode string) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + `
+	resource "ibm_cis_waf_rule_overrides" "test" {
+		cis_id     = data.ibm_cis.cis.id
+		domain_id  = data.ibm_cis_domain.cis_domain.id
+		package_id = "1e334934fd7ae32ad705667f8c1057aa"
+		override {
+			rule_ids = ["100000", "100001"]
+			mode     = "disable"
+		}
+		override {
+			groups = ["Cloudflare Specials"]
+			mode   = "` + mode + `"
+		}
+	  }`
+}
@@ -0,0 +1,214 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const cloudantSchedulerJobsLimit = 100
+
+func DataSourceIBMCloudantReplications() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMCloudantReplicationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CRN of the Cloudant instance",
+			},
+			"extensions": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The extended metadata of the Cloudant instance, with its endpoints",
+			},
+			"jobs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The replication jobs of the replication scheduler",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the replication job",
+						},
+						"database": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The replication document database",
+						},
+						"doc_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the replication document",
+						},
+						"source": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The replication source, without credentials",
+						},
+						"target": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The replication target, without credentials",
+						},
+						"start_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time the replication job started",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the latest event of the job, for example started or crashed",
+						},
+						"error": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The error of the replication job, if any",
+						},
+						"changes_pending": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of changes left to replicate",
+						},
+						"docs_read": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of documents read from the source",
+						},
+						"docs_written": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of documents written to the target",
+						},
+						"doc_write_failures": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of documents that failed to be written to the target",
+						},
+						"history": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The events of the replication job, latest first",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"timestamp": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The time of the event",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The type of the event",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMCloudantReplicationsRead(d *schema.ResourceData, meta interface{}) error {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+	instanceCRN := d.Get("instance_crn").(string)
+	instance, response, err := rsConClient.GetResourceInstance(&rc.GetResourceInstanceOptions{
+		ID: &instanceCRN,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving Cloudant instance %s: %s\n%s", instanceCRN, err, response)
+	}
+	d.Set("extensions", flex.Flatten(instance.Extensions))
+
+	client, err := getCloudantClient(d, meta)
+	if err != nil {
+		return err
+	}
+
+	jobs := []cloudantv1.SchedulerJob{}
+	for {
+		opts := client.NewGetSchedulerJobsOptions()
+		opts.SetLimit(cloudantSchedulerJobsLimit)
+		opts.SetSkip(int64(len(jobs)))
+		result, response, err := client.GetSchedulerJobs(opts)
+		if err != nil {
+			log.Printf("[DEBUG] Error retrieving replication jobs: %s\n%s", err, response)
+			return fmt.Errorf("[ERROR] Error retrieving replication jobs: %s", err)
+		}
+		jobs = append(jobs, result.Jobs...)
+		if len(result.Jobs) == 0 || result.TotalRows == nil || int64(len(jobs)) >= *result.TotalRows {
+			break
+		}
+	}
+
+	d.SetId(instanceCRN)
+	if err = d.Set("jobs", flattenCloudantSchedulerJobs(jobs)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting jobs: %s", err)
+	}
+	return nil
+}
+
+func flattenCloudantSchedulerJobs(jobs []cloudantv1.SchedulerJob) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(jobs))
+	for _, job := range jobs {
+		j := map[string]interface{}{
+			"id":       core.StringNilMapper(job.ID),
+			"database": core.StringNilMapper(job.Database),
+			"doc_id":   core.StringNilMapper(job.DocID),
+			"source":   core.StringNilMapper(job.Source),
+			"target":   core.StringNilMapper(job.Target),
+		}
+		if job.StartTime != nil {
+			j["start_time"] = job.StartTime.String()
+		}
+		history := make([]map[string]interface{}, 0, len(job.History))
+		for _, event := range job.History {
+			e := map[string]interface{}{
+				"type": core.StringNilMapper(event.Type),
+			}
+			if event.Timestamp != nil {
+				e["timestamp"] = event.Timestamp.String()
+			}
+			history = append(history, e)
+		}
+		j["history"] = history
+		if len(history) > 0 {
+			j["state"] = history[0]["type"]
+		}
+		if info := job.Info; info != nil {
+			j["error"] = core.StringNilMapper(info.Error)
+			if info.ChangesPending != nil {
+				j["changes_pending"] = int(*info.ChangesPending)
+			}
+			if info.DocsRead != nil {
+				j["docs_read"] = int(*info.DocsRead)
+			}
+			if info.DocsWritten != nil {
+				j["docs_written"] = int(*info.DocsWritten)
+			}
+			if info.DocWriteFailures != nil {
+				j["doc_write_failures"] = int(*info.DocWriteFailures)
+			}
+		}
+		result = append(result, j)
+	}
+	return result
+}
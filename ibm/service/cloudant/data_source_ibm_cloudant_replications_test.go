@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCloudantReplicationsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.ibm_cloudant_replications.jobs"
+	serviceName := fmt.Sprintf("terraform-test-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCloudantReplicationsDataSourceConfig(serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_crn", "ibm_resource_instance.cloudant", "crn"),
+					resource.TestCheckResourceAttr(dataSourceName, "jobs.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCloudantReplicationsDataSourceConfig(serviceName string) string {
+	return fmt.Sprintf(`
+
+	resource "ibm_resource_instance" "cloudant" {
+	  name     = "%s"
+	  service  = "cloudantnosqldb"
+	  plan     = "lite"
+	  location = "us-south"
+	}
+
+	data "ibm_cloudant_replications" "jobs" {
+	  instance_crn = ibm_resource_instance.cloudant.crn
+	}
+
+	`, serviceName)
+}
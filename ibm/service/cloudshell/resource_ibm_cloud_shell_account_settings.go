@@ -134,15 +134,28 @@ func resourceIBMCloudShellAccountSettingsCreate(context context.Context, d *sche
 	updateAccountSettingsOptions.SetAccountID(d.Get("account_id").(string))
 	if _, ok := d.GetOk("rev"); ok {
 		updateAccountSettingsOptions.SetRev(d.Get("rev").(string))
+	} else {
+		// The settings object always exists for an account, so adopt its current
+		// revision instead of requiring it to be looked up beforehand.
+		getAccountSettingsOptions := &ibmcloudshellv1.GetAccountSettingsOptions{}
+		getAccountSettingsOptions.SetAccountID(d.Get("account_id").(string))
+		currentSettings, response, err := ibmCloudShellClient.GetAccountSettingsWithContext(context, getAccountSettingsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] GetAccountSettingsWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("GetAccountSettingsWithContext failed %s\n%s", err, response))
+		}
+		if currentSettings.Rev != nil {
+			updateAccountSettingsOptions.SetRev(*currentSettings.Rev)
+		}
 	}
-	if _, ok := d.GetOk("default_enable_new_features"); ok {
-		updateAccountSettingsOptions.SetDefaultEnableNewFeatures(d.Get("default_enable_new_features").(bool))
+	if v, ok := d.GetOkExists("default_enable_new_features"); ok {
+		updateAccountSettingsOptions.SetDefaultEnableNewFeatures(v.(bool))
 	}
-	if _, ok := d.GetOk("default_enable_new_regions"); ok {
-		updateAccountSettingsOptions.SetDefaultEnableNewRegions(d.Get("default_enable_new_regions").(bool))
+	if v, ok := d.GetOkExists("default_enable_new_regions"); ok {
+		updateAccountSettingsOptions.SetDefaultEnableNewRegions(v.(bool))
 	}
-	if _, ok := d.GetOk("enabled"); ok {
-		updateAccountSettingsOptions.SetEnabled(d.Get("enabled").(bool))
+	if v, ok := d.GetOkExists("enabled"); ok {
+		updateAccountSettingsOptions.SetEnabled(v.(bool))
 	}
 	if _, ok := d.GetOk("features"); ok {
 		var features []ibmcloudshellv1.Feature
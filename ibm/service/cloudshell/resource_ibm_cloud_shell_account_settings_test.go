@@ -27,6 +27,23 @@ func TestAccIBMCloudShellAccountSettingsBasic(t *testing.T) {
 	})
 }
 
+func TestAccIBMCloudShellAccountSettingsWithoutRev(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCloudShell(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCloudShellAccountSettingsConfigWithoutRev(acc.CloudShellAccountID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cloud_shell_account_settings.cloud_shell_account_settings", "account_id", acc.CloudShellAccountID),
+					resource.TestCheckResourceAttrSet("ibm_cloud_shell_account_settings.cloud_shell_account_settings", "rev"),
+					resource.TestCheckResourceAttr("ibm_cloud_shell_account_settings.cloud_shell_account_settings", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMCloudShellAccountSettingsAllArgs(t *testing.T) {
 	defaultEnableNewFeatures := "false"
 	defaultEnableNewRegions := "true"
@@ -117,6 +134,15 @@ func testAccCheckIBMCloudShellAccountSettingsConfigBasic(accountID string) strin
 	`, accountID, accountID)
 }
 
+func testAccCheckIBMCloudShellAccountSettingsConfigWithoutRev(accountID string) string {
+	return fmt.Sprintf(`
+	resource "ibm_cloud_shell_account_settings" "cloud_shell_account_settings" {
+		account_id = "%s"
+		enabled = false
+	}
+	`, accountID)
+}
+
 func testAccCheckIBMCloudShellAccountSettingsConfig(suffix, accountID string, defaultEnableNewFeatures string, defaultEnableNewRegions string, enabled string, featureWebPreview string, regionJpTok string) string {
 	return fmt.Sprintf(`
 	data "ibm_cloud_shell_account_settings" "account_settings%s" {
@@ -6,6 +6,7 @@ package cos
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
 	"fmt"
 	"io"
@@ -13,6 +14,8 @@ import (
 	"os"
 	"regexp"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	bxsession "github.com/IBM-Cloud/bluemix-go/session"
@@ -24,6 +27,7 @@ import (
 	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -36,6 +40,8 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 		DeleteContext: resourceIBMCOSBucketObjectDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: resourceIBMCOSBucketObjectMultipartCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(20 * time.Minute),
@@ -77,6 +83,30 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				ConflictsWith: []string{"content", "content_base64"},
 				Description:   "COS object content file path",
 			},
+			"multipart_upload": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Upload the content_file in parts, in parallel, resuming the incomplete upload of a previous attempt",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"part_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      16,
+							ValidateFunc: validate.ValidateAllowedRangeInt(5, 5120),
+							Description:  "Size of the parts in MiB",
+						},
+						"concurrency": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      5,
+							ValidateFunc: validate.ValidateAllowedRangeInt(1, 64),
+							Description:  "Number of parts uploaded in parallel",
+						},
+					},
+				},
+			},
 			"content_length": {
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -95,10 +125,11 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				Default:      "public",
 			},
 			"etag": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				Description: "COS object MD5 hexdigest",
+				Type:          schema.TypeString,
+				Computed:      true,
+				Optional:      true,
+				ConflictsWith: []string{"multipart_upload"},
+				Description:   "COS object MD5 hexdigest",
 			},
 			"key": {
 				Type:        schema.TypeString,
@@ -188,14 +219,8 @@ func resourceIBMCOSBucketObjectCreate(ctx context.Context, d *schema.ResourceDat
 		}()
 	}
 
-	putInput := &s3.PutObjectInput{
-		Bucket: aws.String(bucketName),
-		Key:    aws.String(objectKey),
-		Body:   body,
-	}
-
-	if _, err := s3Client.PutObject(putInput); err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+	if err := putCOSObject(ctx, d, s3Client, bucketName, objectKey, body); err != nil {
+		return diag.FromErr(err)
 	}
 
 	objectID := getObjectId(bucketCRN, objectKey, bucketLocation)
@@ -334,14 +359,8 @@ func resourceIBMCOSBucketObjectUpdate(ctx context.Context, d *schema.ResourceDat
 
 		objectKey := d.Get("key").(string)
 
-		putInput := &s3.PutObjectInput{
-			Bucket: aws.String(bucketName),
-			Key:    aws.String(objectKey),
-			Body:   body,
-		}
-
-		if _, err := s3Client.PutObject(putInput); err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+		if err := putCOSObject(ctx, d, s3Client, bucketName, objectKey, body); err != nil {
+			return diag.FromErr(err)
 		}
 
 		objectID := getObjectId(bucketCRN, objectKey, bucketLocation)
@@ -382,6 +401,271 @@ func resourceIBMCOSBucketObjectDelete(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 
+// putCOSObject puts the object body, with a multipart upload if the body is
+// the content_file and multipart_upload is set.
+func putCOSObject(ctx context.Context, d *schema.ResourceData, s3Client *s3.S3, bucketName, objectKey string, body io.ReadSeeker) error {
+	if file, ok := body.(*os.File); ok {
+		if v, ok := d.GetOk("multipart_upload"); ok && v.([]interface{})[0] != nil {
+			multipart := v.([]interface{})[0].(map[string]interface{})
+			partSize := int64(multipart["part_size"].(int)) * 1024 * 1024
+			concurrency := multipart["concurrency"].(int)
+			return cosMultipartUpload(ctx, s3Client, bucketName, objectKey, file, partSize, concurrency)
+		}
+	}
+
+	putInput := &s3.PutObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+		Body:   body,
+	}
+
+	if _, err := s3Client.PutObject(putInput); err != nil {
+		return fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+	}
+	return nil
+}
+
+// resourceIBMCOSBucketObjectMultipartCustomizeDiff plans an update of an
+// object uploaded in parts when the ETag that the content_file would have
+// differs from the ETag of the object, as the ETag of a multipart upload is
+// not the MD5 of the file and etag can't be set to filemd5().
+func resourceIBMCOSBucketObjectMultipartCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.NewValueKnown("content_file") || !diff.NewValueKnown("multipart_upload") {
+		return nil
+	}
+	path, ok := diff.GetOk("content_file")
+	if !ok {
+		return nil
+	}
+	v, ok := diff.GetOk("multipart_upload")
+	if !ok || v.([]interface{})[0] == nil {
+		return nil
+	}
+	partSize := int64(v.([]interface{})[0].(map[string]interface{})["part_size"].(int)) * 1024 * 1024
+	etag, err := cosMultipartETag(path.(string), partSize)
+	if err != nil {
+		return err
+	}
+	if etag != diff.Get("etag").(string) {
+		return diff.SetNew("etag", etag)
+	}
+	return nil
+}
+
+// cosMultipartPartCount returns the number of parts of partSize bytes of a
+// file of size bytes, at least one.
+func cosMultipartPartCount(size, partSize int64) int64 {
+	partCount := (size + partSize - 1) / partSize
+	if partCount == 0 {
+		partCount = 1
+	}
+	return partCount
+}
+
+// cosMultipartETag computes the ETag of the file uploaded in parts of
+// partSize bytes: the MD5 of the MD5 of the parts, followed by the number of
+// parts.
+func cosMultipartETag(path string, partSize int64) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err)
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error reading COS object file (%s): %s", path, err)
+	}
+	partCount := cosMultipartPartCount(info.Size(), partSize)
+	partHashes := md5.New()
+	for i := int64(0); i < partCount; i++ {
+		hash := md5.New()
+		if _, err := io.CopyN(hash, file, partSize); err != nil && err != io.EOF {
+			return "", fmt.Errorf("[ERROR] Error reading COS object file (%s): %s", path, err)
+		}
+		partHashes.Write(hash.Sum(nil))
+	}
+	return fmt.Sprintf("%x-%d", partHashes.Sum(nil), partCount), nil
+}
+
+// cosMultipartUpload uploads the file in parts of partSize bytes, with
+// concurrency parts in flight. It resumes the latest incomplete multipart
+// upload of the object, if any, skipping the parts that were already uploaded
+// with the same content. On failure the uploaded parts are kept, so that
+// applying again resumes the upload.
+func cosMultipartUpload(ctx context.Context, s3Client *s3.S3, bucketName, objectKey string, file *os.File, partSize int64, concurrency int) error {
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error reading COS object file (%s): %s", file.Name(), err)
+	}
+	size := info.Size()
+	partCount := cosMultipartPartCount(size, partSize)
+	if partCount > s3manager.MaxUploadParts {
+		return fmt.Errorf("[ERROR] COS object file (%s) needs %d parts, more than the maximum of %d, increase the part_size", file.Name(), partCount, s3manager.MaxUploadParts)
+	}
+
+	uploadID, uploadedParts, err := findCOSMultipartUpload(ctx, s3Client, bucketName, objectKey)
+	if err != nil {
+		return err
+	}
+	if uploadID == "" {
+		upload, err := s3Client.CreateMultipartUploadWithContext(ctx, &s3.CreateMultipartUploadInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(objectKey),
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error creating multipart upload of object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+		}
+		uploadID = *upload.UploadId
+		log.Printf("[INFO] Uploading object (%s) to COS bucket (%s) in %d parts, upload ID %s", objectKey, bucketName, partCount, uploadID)
+	} else {
+		log.Printf("[INFO] Resuming upload %s of object (%s) to COS bucket (%s), %d of %d parts already uploaded", uploadID, objectKey, bucketName, len(uploadedParts), partCount)
+	}
+
+	partNumbers := make(chan int64)
+	completedParts := make([]*s3.CompletedPart, partCount)
+	errs := make(chan error, concurrency)
+	var done int64
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for partNumber := range partNumbers {
+				offset := (partNumber - 1) * partSize
+				length := partSize
+				if offset+length > size {
+					length = size - offset
+				}
+				etag, err := cosUploadPart(ctx, s3Client, bucketName, objectKey, uploadID, partNumber, io.NewSectionReader(file, offset, length), uploadedParts[partNumber])
+				if err != nil {
+					errs <- err
+					return
+				}
+				completedParts[partNumber-1] = &s3.CompletedPart{
+					ETag:       aws.String(etag),
+					PartNumber: aws.Int64(partNumber),
+				}
+				n := atomic.AddInt64(&done, 1)
+				log.Printf("[INFO] Uploaded part %d of object (%s), %d of %d parts done (%d%%)", partNumber, objectKey, n, partCount, n*100/partCount)
+			}
+		}()
+	}
+
+	var uploadErr error
+	for partNumber := int64(1); partNumber <= partCount && uploadErr == nil; partNumber++ {
+		select {
+		case partNumbers <- partNumber:
+		case uploadErr = <-errs:
+		}
+	}
+	close(partNumbers)
+	wg.Wait()
+	if uploadErr == nil {
+		select {
+		case uploadErr = <-errs:
+		default:
+		}
+	}
+	if uploadErr != nil {
+		return fmt.Errorf("[ERROR] Error uploading object (%s) to COS bucket (%s), apply again to resume upload %s: %s", objectKey, bucketName, uploadID, uploadErr)
+	}
+
+	_, err = s3Client.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(bucketName),
+		Key:             aws.String(objectKey),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: completedParts},
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error completing upload %s of object (%s) in COS bucket (%s): %s", uploadID, objectKey, bucketName, err)
+	}
+	return nil
+}
+
+// findCOSMultipartUpload returns the ID and the uploaded parts, by part number,
+// of the latest incomplete multipart upload of the object, or an empty ID.
+func findCOSMultipartUpload(ctx context.Context, s3Client *s3.S3, bucketName, objectKey string) (string, map[int64]*s3.Part, error) {
+	var latest *s3.MultipartUpload
+	listUploadsInput := &s3.ListMultipartUploadsInput{
+		Bucket: aws.String(bucketName),
+		Prefix: aws.String(objectKey),
+	}
+	for {
+		uploads, err := s3Client.ListMultipartUploadsWithContext(ctx, listUploadsInput)
+		if err != nil {
+			return "", nil, fmt.Errorf("[ERROR] Error listing multipart uploads of COS bucket (%s): %s", bucketName, err)
+		}
+		for _, upload := range uploads.Uploads {
+			if aws.StringValue(upload.Key) != objectKey || upload.UploadId == nil {
+				continue
+			}
+			if latest == nil || aws.TimeValue(upload.Initiated).After(aws.TimeValue(latest.Initiated)) {
+				latest = upload
+			}
+		}
+		if !aws.BoolValue(uploads.IsTruncated) {
+			break
+		}
+		listUploadsInput.KeyMarker = uploads.NextKeyMarker
+		listUploadsInput.UploadIdMarker = uploads.NextUploadIdMarker
+	}
+	if latest == nil {
+		return "", nil, nil
+	}
+
+	parts := map[int64]*s3.Part{}
+	listPartsInput := &s3.ListPartsInput{
+		Bucket:   aws.String(bucketName),
+		Key:      aws.String(objectKey),
+		UploadId: latest.UploadId,
+	}
+	for {
+		result, err := s3Client.ListPartsWithContext(ctx, listPartsInput)
+		if err != nil {
+			return "", nil, fmt.Errorf("[ERROR] Error listing the parts of upload %s of object (%s): %s", *latest.UploadId, objectKey, err)
+		}
+		for _, part := range result.Parts {
+			if part.PartNumber != nil {
+				parts[*part.PartNumber] = part
+			}
+		}
+		if !aws.BoolValue(result.IsTruncated) {
+			break
+		}
+		listPartsInput.PartNumberMarker = result.NextPartNumberMarker
+	}
+	return *latest.UploadId, parts, nil
+}
+
+// cosUploadPart uploads a part and returns its ETag. It skips the upload if
+// the part was already uploaded with the same size and MD5.
+func cosUploadPart(ctx context.Context, s3Client *s3.S3, bucketName, objectKey, uploadID string, partNumber int64, body *io.SectionReader, uploaded *s3.Part) (string, error) {
+	if uploaded != nil && aws.Int64Value(uploaded.Size) == body.Size() {
+		hash := md5.New()
+		if _, err := io.Copy(hash, body); err != nil {
+			return "", err
+		}
+		etag := fmt.Sprintf("\"%x\"", hash.Sum(nil))
+		if aws.StringValue(uploaded.ETag) == etag {
+			return etag, nil
+		}
+		if _, err := body.Seek(0, io.SeekStart); err != nil {
+			return "", err
+		}
+	}
+	result, err := s3Client.UploadPartWithContext(ctx, &s3.UploadPartInput{
+		Bucket:     aws.String(bucketName),
+		Key:        aws.String(objectKey),
+		UploadId:   aws.String(uploadID),
+		PartNumber: aws.Int64(partNumber),
+		Body:       body,
+	})
+	if err != nil {
+		return "", fmt.Errorf("part %d: %s", partNumber, err)
+	}
+	return aws.StringValue(result.ETag), nil
+}
+
 func getCosEndpoint(bucketLocation string, endpointType string) string {
 	if bucketLocation != "" {
 		switch endpointType {
@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"crypto/md5"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestCosMultipartETag(t *testing.T) {
+	part := func(b string) []byte {
+		sum := md5.Sum([]byte(b))
+		return sum[:]
+	}
+	etag := func(parts ...string) string {
+		all := []byte{}
+		for _, p := range parts {
+			all = append(all, part(p)...)
+		}
+		return fmt.Sprintf("%x-%d", md5.Sum(all), len(parts))
+	}
+	cases := []struct {
+		content  string
+		partSize int64
+		want     string
+	}{
+		{"", 5, etag("")},
+		{"hello", 5, etag("hello")},
+		{"hello world!", 5, etag("hello", " worl", "d!")},
+		{"hello world!", 6, etag("hello ", "world!")},
+	}
+	for _, c := range cases {
+		file, err := ioutil.TempFile("", "tf-cos-object")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.Remove(file.Name())
+		if _, err := file.WriteString(c.content); err != nil {
+			t.Fatal(err)
+		}
+		file.Close()
+		got, err := cosMultipartETag(file.Name(), c.partSize)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != c.want {
+			t.Errorf("%q in parts of %d: got %s, want %s", c.content, c.partSize, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	cosBucketACLPrivate    = "private"
+	cosBucketACLPublicRead = "public-read"
+)
+
+func ResourceIBMCOSBucketPublicAccessBlock() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketPublicAccessBlockCreate,
+		ReadContext:   resourceIBMCOSBucketPublicAccessBlockRead,
+		UpdateContext: resourceIBMCOSBucketPublicAccessBlockUpdate,
+		DeleteContext: resourceIBMCOSBucketPublicAccessBlockDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"block_public_acls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Reject requests that set a public ACL on the bucket or its objects",
+			},
+			"ignore_public_acls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Ignore the public ACLs that are set on the bucket and its objects",
+			},
+			"bucket_acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{cosBucketACLPrivate}),
+				Description:  "Set to private to remove the public grants of the bucket ACL, public grants added later are reported as drift",
+			},
+		},
+	}
+}
+
+func resourceIBMCOSBucketPublicAccessBlockCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketLocation := d.Get("bucket_location").(string)
+
+	if err := cosBucketPublicAccessBlockPut(d, m, bucketCRN, bucketLocation); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(getPublicAccessBlockId(bucketCRN, bucketLocation))
+	return resourceIBMCOSBucketPublicAccessBlockRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketPublicAccessBlockRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN, bucketLocation, err := parsePublicAccessBlockId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceCRN, bucketName, err := parsePublicAccessBlockBucketCRN(bucketCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	endpointType := d.Get("endpoint_type").(string)
+
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	out, err := s3Client.GetPublicAccessBlock(&s3.GetPublicAccessBlockInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && (aerr.Code() == "NoSuchBucket" || aerr.Code() == "NoSuchPublicAccessBlockConfiguration") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting public access block of COS bucket (%s): %s", bucketName, err))
+	}
+	if out.PublicAccessBlockConfiguration != nil {
+		d.Set("block_public_acls", aws.BoolValue(out.PublicAccessBlockConfiguration.BlockPublicAcls))
+		d.Set("ignore_public_acls", aws.BoolValue(out.PublicAccessBlockConfiguration.IgnorePublicAcls))
+	}
+
+	if _, ok := d.GetOk("bucket_acl"); ok {
+		aclOut, err := s3Client.GetBucketAcl(&s3.GetBucketAclInput{
+			Bucket: aws.String(bucketName),
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting ACL of COS bucket (%s): %s", bucketName, err))
+		}
+		if cosACLHasPublicGrant(aclOut.Grants) {
+			d.Set("bucket_acl", cosBucketACLPublicRead)
+		} else {
+			d.Set("bucket_acl", cosBucketACLPrivate)
+		}
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketPublicAccessBlockUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("block_public_acls", "ignore_public_acls", "bucket_acl") {
+		bucketCRN, bucketLocation, err := parsePublicAccessBlockId(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := cosBucketPublicAccessBlockPut(d, m, bucketCRN, bucketLocation); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceIBMCOSBucketPublicAccessBlockRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketPublicAccessBlockDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN, bucketLocation, err := parsePublicAccessBlockId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceCRN, bucketName, err := parsePublicAccessBlockBucketCRN(bucketCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = s3Client.DeletePublicAccessBlock(&s3.DeletePublicAccessBlockInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "NoSuchBucket" {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting public access block of COS bucket (%s): %s", bucketName, err))
+	}
+	return nil
+}
+
+// cosBucketPublicAccessBlockPut sets the public access block of the bucket,
+// and, if bucket_acl is private, replaces a public bucket ACL with the private
+// canned ACL.
+func cosBucketPublicAccessBlockPut(d *schema.ResourceData, m interface{}, bucketCRN, bucketLocation string) error {
+	instanceCRN, bucketName, err := parsePublicAccessBlockBucketCRN(bucketCRN)
+	if err != nil {
+		return err
+	}
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	_, err = s3Client.PutPublicAccessBlock(&s3.PutPublicAccessBlockInput{
+		Bucket: aws.String(bucketName),
+		PublicAccessBlockConfiguration: &s3.PublicAccessBlockConfiguration{
+			BlockPublicAcls:  aws.Bool(d.Get("block_public_acls").(bool)),
+			IgnorePublicAcls: aws.Bool(d.Get("ignore_public_acls").(bool)),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error putting public access block of COS bucket (%s): %s", bucketName, err)
+	}
+
+	if d.Get("bucket_acl").(string) == cosBucketACLPrivate {
+		_, err = s3Client.PutBucketAcl(&s3.PutBucketAclInput{
+			Bucket: aws.String(bucketName),
+			ACL:    aws.String(cosBucketACLPrivate),
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error setting private ACL of COS bucket (%s): %s", bucketName, err)
+		}
+	}
+	return nil
+}
+
+// cosACLHasPublicGrant reports whether an ACL grants access to all users or to
+// all authenticated users.
+func cosACLHasPublicGrant(grants []*s3.Grant) bool {
+	for _, grant := range grants {
+		if grant.Grantee == nil || grant.Grantee.URI == nil {
+			continue
+		}
+		uri := *grant.Grantee.URI
+		if strings.HasSuffix(uri, "/AllUsers") || strings.HasSuffix(uri, "/AuthenticatedUsers") {
+			return true
+		}
+	}
+	return false
+}
+
+func getPublicAccessBlockId(bucketCRN string, bucketLocation string) string {
+	return fmt.Sprintf("%s:public_access_block:location:%s", bucketCRN, bucketLocation)
+}
+
+func parsePublicAccessBlockId(id string) (string, string, error) {
+	splitID := strings.Split(id, ":public_access_block:location:")
+	if len(splitID) != 2 || splitID[0] == "" || splitID[1] == "" {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of bucketCRN:public_access_block:location:bucketLocation", id)
+	}
+	return splitID[0], splitID[1], nil
+}
+
+// parsePublicAccessBlockBucketCRN returns the CRN of the COS instance and the
+// name of the bucket of a bucket CRN.
+func parsePublicAccessBlockBucketCRN(bucketCRN string) (string, string, error) {
+	splitCRN := strings.Split(bucketCRN, ":bucket:")
+	if len(splitCRN) != 2 || splitCRN[0] == "" || splitCRN[1] == "" {
+		return "", "", fmt.Errorf("[ERROR] Incorrect bucket CRN %s: CRN should end with :bucket:bucketName", bucketCRN)
+	}
+	return fmt.Sprintf("%s::", splitCRN[0]), splitCRN[1], nil
+}
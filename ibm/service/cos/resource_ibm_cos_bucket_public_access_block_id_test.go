@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"testing"
+)
+
+func TestParsePublicAccessBlockId(t *testing.T) {
+	bucketCRN := "crn:v1:bluemix:public:cloud-object-storage:global:a/acct:instance:bucket:mybucket"
+	cases := []struct {
+		id              string
+		wantInstanceCRN string
+		wantBucketName  string
+		wantLocation    string
+		wantErr         bool
+	}{
+		{id: getPublicAccessBlockId(bucketCRN, "us-south"), wantInstanceCRN: "crn:v1:bluemix:public:cloud-object-storage:global:a/acct:instance::", wantBucketName: "mybucket", wantLocation: "us-south"},
+		{id: bucketCRN, wantErr: true},
+		{id: getPublicAccessBlockId(bucketCRN, ""), wantErr: true},
+		{id: getPublicAccessBlockId("crn:v1:bluemix:public:cloud-object-storage:global:a/acct:instance::", "us-south"), wantErr: true},
+	}
+	for _, c := range cases {
+		crn, location, err := parsePublicAccessBlockId(c.id)
+		if err == nil {
+			var instanceCRN, bucketName string
+			instanceCRN, bucketName, err = parsePublicAccessBlockBucketCRN(crn)
+			if err == nil && (instanceCRN != c.wantInstanceCRN || bucketName != c.wantBucketName || location != c.wantLocation) {
+				t.Errorf("%s: got %s, %s, %s", c.id, instanceCRN, bucketName, location)
+			}
+		}
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: got error %v, want error %t", c.id, err, c.wantErr)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSBucketPublicAccessBlock_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketPublicAccessBlockConfig(name, instanceCRN, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_public_access_block.testacc", "id"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_public_access_block.testacc", "block_public_acls", "true"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_public_access_block.testacc", "ignore_public_acls", "false"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_public_access_block.testacc", "bucket_acl", "private"),
+				),
+			},
+			{
+				Config: testAccIBMCOSBucketPublicAccessBlockConfig(name, instanceCRN, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_public_access_block.testacc", "ignore_public_acls", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketPublicAccessBlockConfig(name string, instanceCRN string, ignorePublicAcls bool) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_public_access_block" "testacc" {
+			bucket_crn         = ibm_cos_bucket.testacc.crn
+			bucket_location    = ibm_cos_bucket.testacc.region_location
+			block_public_acls  = true
+			ignore_public_acls = %[3]t
+			bucket_acl         = "private"
+		}`, name, instanceCRN, ignorePublicAcls)
+}
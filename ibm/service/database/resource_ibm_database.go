@@ -9,10 +9,10 @@ import (
 	"fmt"
 	"log"
 	"net/url"
-	"os"
 	"reflect"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -99,7 +99,8 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			resourceIBMDatabaseInstanceDiff,
-			checkV5Groups),
+			checkV5Groups,
+			resourceIBMDatabaseInstanceVersionDiff),
 
 		Importer: &schema.ResourceImporter{},
 
@@ -190,11 +191,16 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				Description: "The configuration schema in JSON format",
 			},
 			"version": {
-				Description: "The database version to provision if specified",
+				Description: "The database version to provision if specified, upgraded in place for redis and rabbitmq",
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				ForceNew:    true,
+			},
+			"version_upgrade_skip_backup": {
+				Description: "Skip the backup taken before an in-place version upgrade",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
 			},
 			"members_memory_allocation_mb": {
 				Description:   "Memory allocation required for cluster",
@@ -861,6 +867,7 @@ type Params struct {
 	RemoteLeaderID      string `json:"remote_leader_id,omitempty"`
 	PITRDeploymentID    string `json:"point_in_time_recovery_deployment_id,omitempty"`
 	PITRTimeStamp       string `json:"point_in_time_recovery_time,omitempty"`
+	SkipBackup          *bool  `json:"version_upgrade_skip_backup,omitempty"`
 }
 
 type Group struct {
@@ -1062,7 +1069,7 @@ func checkMbValue(name string, limits MbLimit, divider int, diff *schema.Resourc
 }
 
 func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
-	err = flex.ResourceTagsCustomizeDiff(diff)
+	err = flex.ResourceTagsCustomizeDiff(diff, meta)
 	if err != nil {
 		return err
 	}
@@ -1281,13 +1288,11 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 		return diag.FromErr(
 			fmt.Errorf("[ERROR] Error creating database instance: %s %s", err, response))
 	}
-	d.SetId(*instance.ID)
+	flex.TrackCreatedID(d, *instance.ID)
 
 	_, err = waitForDatabaseInstanceCreate(d, meta, *instance.ID)
 	if err != nil {
-		return diag.FromErr(
-			fmt.Errorf(
-				"[ERROR] Error waiting for create database instance (%s) to complete: %s", *instance.ID, err))
+		return flex.TrackedCreateDiagnostics(d, "database instance", err)
 	}
 
 	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
@@ -1353,8 +1358,8 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 		}
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	v := flex.EnvTags(meta)
+	if _, ok := d.GetOk("tags"); ok || len(v) > 0 {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -1656,6 +1661,52 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 	return nil
 }
 
+// The services that support in-place major version upgrades, other services
+// are replaced on a version change.
+var databaseInPlaceUpgradeServices = map[string]bool{
+	"databases-for-redis":   true,
+	"messages-for-rabbitmq": true,
+}
+
+func resourceIBMDatabaseInstanceVersionDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("version") {
+		return nil
+	}
+	// A version that is not known yet is not compared, a version that was
+	// not read before is compared as the lowest one
+	oldVersion, newVersion := diff.GetChange("version")
+	if newVersion.(string) == "" {
+		return nil
+	}
+	service := diff.Get("service").(string)
+	if !databaseInPlaceUpgradeServices[service] || compareDatabaseVersions(newVersion.(string), oldVersion.(string)) < 0 {
+		return diff.ForceNew("version")
+	}
+	return nil
+}
+
+// compareDatabaseVersions compares dotted versions, for example 5 and 6.2,
+// returning -1, 0 or 1.
+func compareDatabaseVersions(a, b string) int {
+	aParts, bParts := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var aPart, bPart int
+		if i < len(aParts) {
+			aPart, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			bPart, _ = strconv.Atoi(bParts[i])
+		}
+		if aPart < bPart {
+			return -1
+		}
+		if aPart > bPart {
+			return 1
+		}
+	}
+	return 0
+}
+
 func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -1672,9 +1723,16 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		updateReq.Name = &name
 		update = true
 	}
-	if d.HasChange("service_endpoints") {
+	if d.HasChange("service_endpoints") || d.HasChange("version") {
 		params := Params{}
-		params.ServiceEndpoints = d.Get("service_endpoints").(string)
+		if d.HasChange("service_endpoints") {
+			params.ServiceEndpoints = d.Get("service_endpoints").(string)
+		}
+		if d.HasChange("version") {
+			params.Version = d.Get("version").(string)
+			skipBackup := d.Get("version_upgrade_skip_backup").(bool)
+			params.SkipBackup = &skipBackup
+		}
 		parameters, _ := json.Marshal(params)
 		var raw map[string]interface{}
 		json.Unmarshal(parameters, &raw)
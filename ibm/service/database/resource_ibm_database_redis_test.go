@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccIBMDatabaseInstance_Redis_Basic(t *testing.T) {
@@ -144,6 +145,44 @@ func TestAccIBMDatabaseInstanceRedisKP_Encrypt(t *testing.T) {
 
 // func testAccCheckIBMDatabaseInstanceDestroy(s *terraform.State) etc in resource_ibm_database_postgresql_test.go
 
+func TestAccIBMDatabaseInstance_Redis_VersionUpgrade(t *testing.T) {
+	t.Parallel()
+	databaseResourceGroup := "default"
+	var databaseInstanceOne string
+	var databaseInstanceTwo string
+	rnd := fmt.Sprintf("tf-redis-%d", acctest.RandIntRange(10, 100))
+	testName := rnd
+	name := "ibm_database." + testName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseInstanceRedisVersion(databaseResourceGroup, testName, "5"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(name, &databaseInstanceOne),
+					resource.TestCheckResourceAttr(name, "version", "5"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabaseInstanceRedisVersion(databaseResourceGroup, testName, "6"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(name, &databaseInstanceTwo),
+					resource.TestCheckResourceAttr(name, "version", "6"),
+					func(s *terraform.State) error {
+						if databaseInstanceOne != databaseInstanceTwo {
+							return fmt.Errorf("Database instance was replaced on version upgrade, %s != %s", databaseInstanceOne, databaseInstanceTwo)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMDatabaseInstanceRedisBasic(databaseResourceGroup string, name string) string {
 	return fmt.Sprintf(`
 	data "ibm_resource_group" "test_acc" {
@@ -299,3 +338,24 @@ func testAccCheckIBMDatabaseInstanceRedisKPEncrypt(databaseResourceGroup string,
 	}
 				`, databaseResourceGroup, kpInstanceName, kpKeyName, kpByokName, name)
 }
+
+func testAccCheckIBMDatabaseInstanceRedisVersion(databaseResourceGroup string, name string, version string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+		# name = "%[1]s"
+	  }
+
+	  resource "ibm_database" "%[2]s" {
+		resource_group_id            = data.ibm_resource_group.test_acc.id
+		name                         = "%[2]s"
+		service                      = "databases-for-redis"
+		plan                         = "standard"
+		location                     = "us-south"
+		version                      = "%[3]s"
+		adminpassword                = "password12"
+		members_memory_allocation_mb = 2048
+		members_disk_allocation_mb   = 2048
+	  }
+				`, databaseResourceGroup, name, version)
+}
@@ -38,6 +38,11 @@ func DataSourceIBMDLLocations() *schema.Resource {
 				ValidateFunc: validate.ValidateAllowedStringValues([]string{"dedicated", "connect"}),
 				Description:  "The Direct Link offering type. Current supported values (dedicated and connect).",
 			},
+			dlProvisionEnabled: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only list the locations whose gateway provisioning support for the offering type matches this value",
+			},
 			dlLocations: {
 				Type:        schema.TypeList,
 				Description: "Collection of valid locations for the specified Direct Link offering.",
@@ -122,8 +127,12 @@ func dataSourceIBMDLOfferingLocationsRead(d *schema.ResourceData, meta interface
 		return fmt.Errorf("[ERROR] Error while listing directlink gateway's locations %s\n%s", err, response)
 	}
 
+	provisionEnabled, filterProvisionEnabled := d.GetOkExists(dlProvisionEnabled)
 	locations := make([]map[string]interface{}, 0)
 	for _, instance := range listLocations.Locations {
+		if filterProvisionEnabled && (instance.ProvisionEnabled == nil || *instance.ProvisionEnabled != provisionEnabled.(bool)) {
+			continue
+		}
 		location := map[string]interface{}{}
 		if instance.BuildingColocationOwner != nil {
 			location[dlBuildingColocationOwner] = *instance.BuildingColocationOwner
@@ -37,3 +37,29 @@ func testAccCheckIBMDLOfferingLocationsDataSourceConfig(offeringType string) str
 
 	  `, offeringType)
 }
+
+func TestAccIBMDLLocationsDataSource_provisionEnabled(t *testing.T) {
+	node := "data.ibm_dl_locations.test_dl_locations"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDLOfferingLocationsDataSourceProvisionEnabledConfig("connect"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(node, "locations.0.name"),
+					resource.TestCheckResourceAttr(node, "locations.0.provision_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDLOfferingLocationsDataSourceProvisionEnabledConfig(offeringType string) string {
+	return fmt.Sprintf(`
+	   data "ibm_dl_locations" "test_dl_locations"{
+		offering_type     = "%s"
+		provision_enabled = true
+	 }
+	  `, offeringType)
+}
@@ -35,6 +35,16 @@ func DataSourceIBMDirectLinkPorts() *schema.Resource {
 				Optional:    true,
 				Description: "Direct Link location short name",
 			},
+			dlLinkSpeed: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Only list the ports that support this speed in megabits per second",
+			},
+			dlProviderName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the ports of this provider",
+			},
 			dlPorts: {
 
 				Type:        schema.TypeList,
@@ -121,8 +131,16 @@ func dataSourceIBMDirectLinkPortsRead(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	linkSpeed := int64(d.Get(dlLinkSpeed).(int))
+	providerName := d.Get(dlProviderName).(string)
 	portCollections := make([]map[string]interface{}, 0)
 	for _, port := range allrecs {
+		if providerName != "" && (port.ProviderName == nil || *port.ProviderName != providerName) {
+			continue
+		}
+		if linkSpeed != 0 && !dlPortSupportsLinkSpeed(port, linkSpeed) {
+			continue
+		}
 		portCollection := map[string]interface{}{}
 		portCollection[dlPortID] = *port.ID
 		portCollection[dlCount] = *port.DirectLinkCount
@@ -142,6 +160,16 @@ func dataSourceIBMDirectLinkPortsRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+// dlPortSupportsLinkSpeed reports whether speed is one of the port's supported link speeds.
+func dlPortSupportsLinkSpeed(port dl.Port, speed int64) bool {
+	for _, s := range port.SupportedLinkSpeeds {
+		if s == speed {
+			return true
+		}
+	}
+	return false
+}
+
 func dataSourceIBMDirectLinkPortsReadID(d *schema.ResourceData) string {
 	return time.Now().UTC().String()
 }
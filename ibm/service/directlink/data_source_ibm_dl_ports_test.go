@@ -37,3 +37,29 @@ func testAccCheckIBMDLPortsDataSourceConfig(name string) string {
 	   }
 	  `, name)
 }
+
+func TestAccIBMDLPortsDataSource_linkSpeed(t *testing.T) {
+	resName := "data.ibm_dl_ports.test_dl_ports_speed"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDLPortsDataSourceLinkSpeedConfig(1000),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "ports.0.port_id"),
+					resource.TestCheckTypeSetElemAttr(resName, "ports.0.supported_link_speeds.*", "1000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDLPortsDataSourceLinkSpeedConfig(speed int) string {
+	return fmt.Sprintf(`
+	   data "ibm_dl_ports" "test_dl_ports_speed" {
+		   location_name = "dal10"
+		   link_speed    = %d
+	   }
+	  `, speed)
+}
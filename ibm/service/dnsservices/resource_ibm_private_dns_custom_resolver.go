@@ -7,17 +7,22 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
@@ -40,6 +45,10 @@ const (
 	pdnsCustomResolverDegraded  = "DEGRADED"
 	pdnsCustomResolverHealthy   = "HEALTHY"
 	pdnsCRHighAvailability      = "high_availability"
+	pdnsCRLocationSelector      = "location_selector"
+	pdnsCRSelectorSubnetCrns    = "subnet_crns"
+	pdnsCRSelectorPerZone       = "locations_per_zone"
+	pdnsCRSelectedSubnetCrns    = "selected_subnet_crns"
 )
 
 func ResourceIBMPrivateDNSCustomResolver() *schema.Resource {
@@ -57,6 +66,12 @@ func ResourceIBMPrivateDNSCustomResolver() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMPrivateDNSCustomResolverSelectorCustomizeDiff(diff, v)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 			pdnsInstanceID: {
 				Type:        schema.TypeString,
@@ -133,6 +148,37 @@ func ResourceIBMPrivateDNSCustomResolver() *schema.Resource {
 					},
 				},
 			},
+			pdnsCRLocationSelector: {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{pdnsCustomResolverLocations},
+				Description:   "Select the subnets of the resolver locations from candidate subnets, spread across their zones",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						pdnsCRSelectorSubnetCrns: {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "CRNs of the candidate subnets, in order of preference",
+						},
+						pdnsCRSelectorPerZone: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(1, 4),
+							Description:  "Number of resolver locations per zone of the candidate subnets",
+						},
+					},
+				},
+			},
+			pdnsCRSelectedSubnetCrns: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "CRNs of the subnets selected by location_selector",
+			},
 			pdnsCRForwardRules: {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -208,7 +254,24 @@ func resouceIBMPrivateDNSCustomResolverCreate(context context.Context, d *schema
 	cr_highaval := d.Get(pdnsCRHighAvailability).(bool)
 
 	crLocationCreate := false
-	if _, ok := d.GetOk(pdnsCustomResolverLocations); ok {
+	if _, ok := d.GetOk(pdnsCRLocationSelector); ok {
+		crLocationCreate = true
+		selected, err := pdnsCRSelectSubnets(d.Get, meta, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if cr_highaval && len(selected) <= 1 {
+			return diag.FromErr(fmt.Errorf("To meet high availability status, configure custom resolvers with a minimum of two resolver locations. The location_selector selected %d subnets, add candidate subnets or increase locations_per_zone.", len(selected)))
+		}
+		locations := make([]dnssvcsv1.LocationInput, 0, len(selected))
+		for _, subnetCrn := range selected {
+			locations = append(locations, dnssvcsv1.LocationInput{
+				SubnetCrn: core.StringPtr(subnetCrn),
+				Enabled:   core.BoolPtr(true),
+			})
+		}
+		customResolverOption.SetLocations(locations)
+	} else if _, ok := d.GetOk(pdnsCustomResolverLocations); ok {
 		crLocationCreate = true
 		crLocations := d.Get(pdnsCustomResolverLocations).(*schema.Set)
 		if cr_highaval && crLocations.Len() <= 1 {
@@ -286,6 +349,15 @@ func resouceIBMPrivateDNSCustomResolverRead(context context.Context, d *schema.R
 	d.Set(pdnsCREnabled, *result.Enabled)
 	d.Set(pdnsCustomResolverLocations, flattenPdnsCRLocations(result.Locations))
 	d.Set(pdnsCRForwardRules, forwardRules)
+	if _, ok := d.GetOk(pdnsCRLocationSelector); ok {
+		selected := make([]string, 0, len(result.Locations))
+		for _, location := range result.Locations {
+			selected = append(selected, *location.SubnetCrn)
+		}
+		d.Set(pdnsCRSelectedSubnetCrns, selected)
+	} else {
+		d.Set(pdnsCRSelectedSubnetCrns, nil)
+	}
 	return nil
 }
 
@@ -325,6 +397,12 @@ func resouceIBMPrivateDNSCustomResolverUpdate(context context.Context, d *schema
 
 	}
 
+	if _, ok := d.GetOk(pdnsCRLocationSelector); ok && !d.IsNewResource() && (d.HasChange(pdnsCRLocationSelector) || d.HasChange(pdnsCRSelectedSubnetCrns)) {
+		if err = pdnsCRReconcileLocations(context, d, meta, crn, customResolverID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resouceIBMPrivateDNSCustomResolverRead(context, d, meta)
 }
 
@@ -451,3 +529,209 @@ func waitForPDNSCustomResolverHealthy(d *schema.ResourceData, meta interface{})
 
 	return stateConf.WaitForState()
 }
+
+func resourceIBMPrivateDNSCustomResolverSelectorCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if _, ok := diff.GetOk(pdnsCRLocationSelector); !ok {
+		return nil
+	}
+	if !diff.NewValueKnown(pdnsCRLocationSelector) {
+		return diff.SetNewComputed(pdnsCRSelectedSubnetCrns)
+	}
+	current := flex.ExpandStringList(diff.Get(pdnsCRSelectedSubnetCrns).([]interface{}))
+	selected, err := pdnsCRSelectSubnets(diff.Get, meta, current)
+	if err != nil {
+		return err
+	}
+	if diff.Get(pdnsCRHighAvailability).(bool) && len(selected) <= 1 {
+		return fmt.Errorf("To meet high availability status, configure custom resolvers with a minimum of two resolver locations. The location_selector selected %d subnets, add candidate subnets or increase locations_per_zone.", len(selected))
+	}
+	if !pdnsCRSameSubnets(current, selected) {
+		log.Printf("[INFO] The locations of custom resolver %s will move from subnets %v to %v", diff.Id(), current, selected)
+		return diff.SetNew(pdnsCRSelectedSubnetCrns, selected)
+	}
+	return nil
+}
+
+// pdnsCRSelectSubnets selects the subnets of the resolver locations from the
+// candidate subnets of the location_selector that exist, taking up to
+// locations_per_zone subnets per zone. The current subnets are kept if they
+// are still candidates and exist, so that the locations only move when the
+// selector changes or a subnet is deleted.
+func pdnsCRSelectSubnets(get func(string) interface{}, meta interface{}, current []string) ([]string, error) {
+	candidates := flex.ExpandStringList(get(pdnsCRLocationSelector + ".0." + pdnsCRSelectorSubnetCrns).([]interface{}))
+	perZone := get(pdnsCRLocationSelector + ".0." + pdnsCRSelectorPerZone).(int)
+
+	isCurrent := map[string]bool{}
+	for _, subnetCrn := range current {
+		isCurrent[subnetCrn] = true
+	}
+	zones := []string{}
+	byZone := map[string][]string{}
+	for _, subnetCrn := range candidates {
+		zone, _, err := pdnsSubnetCrnParts(subnetCrn)
+		if err != nil {
+			return nil, err
+		}
+		exists, err := pdnsCRSubnetExists(meta, subnetCrn)
+		if err != nil {
+			// The location of a selected subnet is not moved because of a failed lookup
+			if !isCurrent[subnetCrn] {
+				return nil, err
+			}
+			log.Printf("[WARN] Selected subnet %s of the custom resolver could not be looked up, its location is kept: %s", subnetCrn, err)
+			exists = true
+		}
+		if !exists {
+			// A deleted subnet is not selected, another candidate of its zone takes over
+			log.Printf("[WARN] Candidate subnet %s of the custom resolver does not exist, it is not selected", subnetCrn)
+			continue
+		}
+		if _, ok := byZone[zone]; !ok {
+			zones = append(zones, zone)
+		}
+		byZone[zone] = append(byZone[zone], subnetCrn)
+	}
+
+	sort.Strings(zones)
+	selected := []string{}
+	for _, zone := range zones {
+		inZone := []string{}
+		for _, subnetCrn := range byZone[zone] {
+			if isCurrent[subnetCrn] && len(inZone) < perZone {
+				inZone = append(inZone, subnetCrn)
+			}
+		}
+		for _, subnetCrn := range byZone[zone] {
+			if !isCurrent[subnetCrn] && len(inZone) < perZone {
+				inZone = append(inZone, subnetCrn)
+			}
+		}
+		selected = append(selected, inZone...)
+	}
+	return selected, nil
+}
+
+// pdnsCRReconcileLocations adds the locations of the selected subnets and then
+// deletes the other locations, so that the resolver keeps running locations.
+func pdnsCRReconcileLocations(context context.Context, d *schema.ResourceData, meta interface{}, crn, customResolverID string) error {
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return err
+	}
+	current, _ := d.GetChange(pdnsCRSelectedSubnetCrns)
+	selected, err := pdnsCRSelectSubnets(d.Get, meta, flex.ExpandStringList(current.([]interface{})))
+	if err != nil {
+		return err
+	}
+
+	opt := sess.NewGetCustomResolverOptions(crn, customResolverID)
+	result, response, err := sess.GetCustomResolverWithContext(context, opt)
+	if err != nil || result == nil {
+		return fmt.Errorf("[ERROR] Error reading the custom resolver %s:%s", err, response)
+	}
+	isSelected := map[string]bool{}
+	for _, subnetCrn := range selected {
+		isSelected[subnetCrn] = true
+	}
+	existing := map[string]bool{}
+	for _, location := range result.Locations {
+		existing[*location.SubnetCrn] = true
+	}
+
+	for _, subnetCrn := range selected {
+		if existing[subnetCrn] {
+			continue
+		}
+		addOpt := sess.NewAddCustomResolverLocationOptions(crn, customResolverID)
+		addOpt.SetSubnetCrn(subnetCrn)
+		addOpt.SetEnabled(true)
+		_, response, err := sess.AddCustomResolverLocationWithContext(context, addOpt)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error adding the custom resolver location in subnet %s:%s:%s", subnetCrn, err, response)
+		}
+	}
+
+	for _, location := range result.Locations {
+		if isSelected[*location.SubnetCrn] {
+			continue
+		}
+		updateOpt := sess.NewUpdateCustomResolverLocationOptions(crn, customResolverID, *location.ID)
+		updateOpt.SetEnabled(false)
+		_, response, err := sess.UpdateCustomResolverLocationWithContext(context, updateOpt)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error disabling the custom resolver location %s:%s:%s", *location.ID, err, response)
+		}
+		deleteOpt := sess.NewDeleteCustomResolverLocationOptions(crn, customResolverID, *location.ID)
+		response, err = sess.DeleteCustomResolverLocationWithContext(context, deleteOpt)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error deleting the custom resolver location %s:%s:%s", *location.ID, err, response)
+		}
+	}
+
+	_, err = waitForPDNSCustomResolverHealthy(d, meta)
+	return err
+}
+
+// pdnsCRSubnets caches the existence of the candidate subnets for the run of the
+// provider, the selector is evaluated on every plan by the CustomizeDiff.
+var pdnsCRSubnets sync.Map
+
+// pdnsCRSubnetExists looks the subnet up in the region of its CRN, which is not
+// necessarily the region of the provider. A subnet that is not found does not
+// exist, other lookup errors are returned and not cached.
+func pdnsCRSubnetExists(meta interface{}, subnetCrn string) (bool, error) {
+	if exists, ok := pdnsCRSubnets.Load(subnetCrn); ok {
+		return exists.(bool), nil
+	}
+	zone, subnetID, err := pdnsSubnetCrnParts(subnetCrn)
+	if err != nil {
+		return false, err
+	}
+	sess, err := meta.(conns.ClientSession).VpcV1APIForRegion(pdnsZoneRegion(zone))
+	if err != nil {
+		return false, err
+	}
+	_, response, err := sess.GetSubnet(&vpcv1.GetSubnetOptions{ID: &subnetID})
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return false, fmt.Errorf("[ERROR] Error getting candidate subnet %s: %s\n%s", subnetCrn, err, response)
+	}
+	pdnsCRSubnets.Store(subnetCrn, err == nil)
+	return err == nil, nil
+}
+
+// pdnsZoneRegion returns the region of a zone, for example us-south of us-south-1.
+func pdnsZoneRegion(zone string) string {
+	if i := strings.LastIndex(zone, "-"); i > 0 {
+		return zone[:i]
+	}
+	return zone
+}
+
+// pdnsSubnetCrnParts returns the zone and the ID of a VPC subnet CRN, for
+// example crn:v1:bluemix:public:is:us-south-1:a/<account>::subnet:<id>.
+func pdnsSubnetCrnParts(subnetCrn string) (string, string, error) {
+	parts := strings.Split(subnetCrn, ":")
+	if len(parts) != 10 || parts[4] != "is" || parts[8] != "subnet" || parts[5] == "" || parts[9] == "" {
+		return "", "", fmt.Errorf("[ERROR] %s is not the CRN of a VPC subnet", subnetCrn)
+	}
+	return parts[5], parts[9], nil
+}
+
+func pdnsCRSameSubnets(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	inA := map[string]bool{}
+	for _, subnetCrn := range a {
+		inA[subnetCrn] = true
+	}
+	for _, subnetCrn := range b {
+		if !inA[subnetCrn] {
+			return false
+		}
+	}
+	return true
+}
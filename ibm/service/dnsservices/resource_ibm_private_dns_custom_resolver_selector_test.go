@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package dnsservices
+
+import (
+	"fmt"
+	gohttp "net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+type customResolverSubnetSession struct {
+	conns.ClientSession
+	vpcAPI *vpcv1.VpcV1
+}
+
+func (s customResolverSubnetSession) VpcV1APIForRegion(region string) (*vpcv1.VpcV1, error) {
+	return s.vpcAPI, nil
+}
+
+func TestPdnsCRSelectSubnets(t *testing.T) {
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/subnets/")
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasPrefix(id, "deleted"):
+			w.WriteHeader(gohttp.StatusNotFound)
+			fmt.Fprint(w, `{"errors": [{"code": "not_found"}]}`)
+		case strings.HasPrefix(id, "failing"):
+			w.WriteHeader(gohttp.StatusInternalServerError)
+			fmt.Fprint(w, `{"errors": [{"code": "internal_error"}]}`)
+		default:
+			fmt.Fprintf(w, `{"id": "%s"}`, id)
+		}
+	}))
+	defer server.Close()
+	vpcAPI, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	meta := customResolverSubnetSession{vpcAPI: vpcAPI}
+
+	subnet := func(zone, id string) string {
+		return fmt.Sprintf("crn:v1:bluemix:public:is:%s:a/account::subnet:%s", zone, id)
+	}
+	cases := []struct {
+		name       string
+		candidates []string
+		current    []string
+		want       []string
+		wantErr    bool
+	}{
+		{
+			name:       "deleted selected subnet is replaced in its zone",
+			candidates: []string{subnet("us-south-1", "deleted-1"), subnet("us-south-1", "a-1"), subnet("us-south-2", "b-2")},
+			current:    []string{subnet("us-south-1", "deleted-1"), subnet("us-south-2", "b-2")},
+			want:       []string{subnet("us-south-1", "a-1"), subnet("us-south-2", "b-2")},
+		},
+		{
+			name:       "deleted candidate is skipped",
+			candidates: []string{subnet("us-south-1", "deleted-2"), subnet("us-south-2", "c-2")},
+			want:       []string{subnet("us-south-2", "c-2")},
+		},
+		{
+			name:       "selected subnet is kept on a failed lookup",
+			candidates: []string{subnet("us-south-1", "failing-1"), subnet("us-south-1", "d-1")},
+			current:    []string{subnet("us-south-1", "failing-1")},
+			want:       []string{subnet("us-south-1", "failing-1")},
+		},
+		{
+			name:       "failed lookup of a new candidate",
+			candidates: []string{subnet("us-south-1", "failing-2")},
+			wantErr:    true,
+		},
+	}
+	for _, c := range cases {
+		candidates := make([]interface{}, len(c.candidates))
+		for i, subnetCrn := range c.candidates {
+			candidates[i] = subnetCrn
+		}
+		get := func(k string) interface{} {
+			if strings.HasSuffix(k, pdnsCRSelectorPerZone) {
+				return 1
+			}
+			return candidates
+		}
+		got, err := pdnsCRSelectSubnets(get, meta, c.current)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: got error %v, want error %t", c.name, err, c.wantErr)
+			continue
+		}
+		if !c.wantErr && !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+}
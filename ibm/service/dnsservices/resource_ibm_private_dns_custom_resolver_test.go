@@ -68,6 +68,78 @@ func TestAccIBMPrivateDNSCustomResolverImport(t *testing.T) {
 	})
 }
 
+func TestAccIBMPrivateDNSCustomResolver_locationSelector(t *testing.T) {
+	var resultprivatedns string
+	vpcname := fmt.Sprintf("cr-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("cr-subnet-name-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("testpdnscustomresolver%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPrivateDNSCustomResolverLocationSelector(vpcname, subnetname, acc.ISZoneName, name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPrivateDNSCustomResolverExists("ibm_dns_custom_resolver.test", resultprivatedns),
+					resource.TestCheckResourceAttr("ibm_dns_custom_resolver.test", "selected_subnet_crns.#", "1"),
+					resource.TestCheckResourceAttrPair("ibm_dns_custom_resolver.test", "selected_subnet_crns.0", "ibm_is_subnet.test-pdns-cr-subnet1", "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPrivateDNSCustomResolverLocationSelector(vpcname, subnetname, acc.ISZoneName, name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPrivateDNSCustomResolverExists("ibm_dns_custom_resolver.test", resultprivatedns),
+					resource.TestCheckResourceAttr("ibm_dns_custom_resolver.test", "selected_subnet_crns.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPrivateDNSCustomResolverLocationSelector(vpcname, subnetname, zone, name string, perZone int) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "rg" {
+		is_default	= true
+	}
+	resource "ibm_is_vpc" "test-pdns-cr-vpc" {
+		name			= "%[1]s"
+		resource_group	= data.ibm_resource_group.rg.id
+	}
+	resource "ibm_is_subnet" "test-pdns-cr-subnet1" {
+		name						= "%[2]s-1"
+		vpc							= ibm_is_vpc.test-pdns-cr-vpc.id
+		zone						= "%[3]s"
+		total_ipv4_address_count	= 16
+		resource_group				= data.ibm_resource_group.rg.id
+	}
+	resource "ibm_is_subnet" "test-pdns-cr-subnet2" {
+		name						= "%[2]s-2"
+		vpc							= ibm_is_vpc.test-pdns-cr-vpc.id
+		zone						= "%[3]s"
+		total_ipv4_address_count	= 16
+		resource_group				= data.ibm_resource_group.rg.id
+	}
+	resource "ibm_resource_instance" "test-pdns-cr-instance" {
+		name				= "test-pdns-cr-instance"
+		resource_group_id	= data.ibm_resource_group.rg.id
+		location			= "global"
+		service				= "dns-svcs"
+		plan				= "standard-dns"
+	}
+	resource "ibm_dns_custom_resolver" "test" {
+		name				= "%[4]s"
+		instance_id			= ibm_resource_instance.test-pdns-cr-instance.guid
+		high_availability	= false
+		enabled				= true
+		location_selector {
+			subnet_crns			= [ibm_is_subnet.test-pdns-cr-subnet1.crn, ibm_is_subnet.test-pdns-cr-subnet2.crn]
+			locations_per_zone	= %[5]d
+		}
+	}
+	`, vpcname, subnetname, zone, name, perZone)
+}
+
 func testAccCheckIBMPrivateDNSCustomResolverBasic(vpcname, subnetname, zone, cidr, name, description string) string {
 	return fmt.Sprintf(`
 	data "ibm_resource_group" "rg" {
@@ -0,0 +1,248 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package enterprise
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+)
+
+func DataSourceIBMEnterpriseHierarchy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmEnterpriseHierarchyRead,
+
+		Schema: map[string]*schema.Schema{
+			"enterprise_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the enterprise to read the hierarchy of.",
+			},
+			"parent": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The CRN of an account group. When set, only the account groups and accounts below it are returned.",
+			},
+			"account_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The account groups of the hierarchy, parents before children.",
+				Elem: &schema.Resource{
+					Schema: dataSourceIbmEnterpriseHierarchyNodeSchema("account group"),
+				},
+			},
+			"accounts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The accounts of the hierarchy.",
+				Elem: &schema.Resource{
+					Schema: dataSourceIbmEnterpriseHierarchyNodeSchema("account"),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIbmEnterpriseHierarchyNodeSchema(kind string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The %s ID.", kind),
+		},
+		"crn": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The Cloud Resource Name (CRN) of the %s.", kind),
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The name of the %s.", kind),
+		},
+		"parent": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The CRN of the parent of the %s.", kind),
+		},
+		"enterprise_path": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The path from the enterprise to this particular %s.", kind),
+		},
+		"depth": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: fmt.Sprintf("The number of levels between the enterprise and the %s. Direct children of the enterprise have a depth of 1.", kind),
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The state of the %s.", kind),
+		},
+	}
+}
+
+func dataSourceIbmEnterpriseHierarchyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enterpriseManagementClient, err := meta.(conns.ClientSession).EnterpriseManagementV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	enterpriseID := d.Get("enterprise_id").(string)
+
+	next_docid := ""
+	var accountGroups []enterprisemanagementv1.AccountGroup
+	for {
+		listAccountGroupsOptions := &enterprisemanagementv1.ListAccountGroupsOptions{}
+		listAccountGroupsOptions.SetEnterpriseID(enterpriseID)
+		if next_docid != "" {
+			listAccountGroupsOptions.NextDocid = &next_docid
+		}
+		listAccountGroupsResponse, response, err := enterpriseManagementClient.ListAccountGroupsWithContext(context, listAccountGroupsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListAccountGroupsWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+		next_docid, err = getEnterpriseNext(listAccountGroupsResponse.NextURL)
+		if err != nil {
+			log.Printf("[DEBUG] ListAccountGroupsWithContext failed. Error occurred while parsing NextURL: %s", err)
+			return diag.FromErr(err)
+		}
+		accountGroups = append(accountGroups, listAccountGroupsResponse.Resources...)
+		if next_docid == "" {
+			break
+		}
+	}
+
+	var accounts []enterprisemanagementv1.Account
+	for {
+		listAccountsOptions := &enterprisemanagementv1.ListAccountsOptions{}
+		listAccountsOptions.SetEnterpriseID(enterpriseID)
+		if next_docid != "" {
+			listAccountsOptions.NextDocid = &next_docid
+		}
+		listAccountsResponse, response, err := enterpriseManagementClient.ListAccountsWithContext(context, listAccountsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListAccountsWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+		next_docid, err = getEnterpriseNext(listAccountsResponse.NextURL)
+		if err != nil {
+			log.Printf("[DEBUG] ListAccountsWithContext failed. Error occurred while parsing NextURL: %s", err)
+			return diag.FromErr(err)
+		}
+		accounts = append(accounts, listAccountsResponse.Resources...)
+		if next_docid == "" {
+			break
+		}
+	}
+
+	// Index the account groups by CRN so that every node can be placed in the tree.
+	groupParents := make(map[string]string, len(accountGroups))
+	for _, group := range accountGroups {
+		if group.CRN != nil && group.Parent != nil {
+			groupParents[*group.CRN] = *group.Parent
+		}
+	}
+	depthOf := func(parent string) int {
+		depth := 1
+		for seen := 0; seen <= len(groupParents); seen++ {
+			next, ok := groupParents[parent]
+			if !ok {
+				break
+			}
+			depth++
+			parent = next
+		}
+		return depth
+	}
+	root := d.Get("parent").(string)
+	if root != "" {
+		if _, ok := groupParents[root]; !ok {
+			return diag.FromErr(fmt.Errorf("[ERROR] No account group found in enterprise %s with CRN %s", enterpriseID, root))
+		}
+	}
+	below := func(parent string) bool {
+		if root == "" {
+			return true
+		}
+		for seen := 0; seen <= len(groupParents); seen++ {
+			if parent == root {
+				return true
+			}
+			next, ok := groupParents[parent]
+			if !ok {
+				return false
+			}
+			parent = next
+		}
+		return false
+	}
+
+	groupList := []map[string]interface{}{}
+	for _, group := range accountGroups {
+		parent := ""
+		if group.Parent != nil {
+			parent = *group.Parent
+		}
+		if !below(parent) {
+			continue
+		}
+		groupList = append(groupList, map[string]interface{}{
+			"id":              core.StringNilMapper(group.ID),
+			"crn":             core.StringNilMapper(group.CRN),
+			"name":            core.StringNilMapper(group.Name),
+			"parent":          parent,
+			"enterprise_path": core.StringNilMapper(group.EnterprisePath),
+			"depth":           depthOf(parent),
+			"state":           core.StringNilMapper(group.State),
+		})
+	}
+	// Parents first, so that for_each over the groups can reference the group they belong to.
+	sort.SliceStable(groupList, func(i, j int) bool {
+		return groupList[i]["depth"].(int) < groupList[j]["depth"].(int)
+	})
+
+	accountList := []map[string]interface{}{}
+	for _, account := range accounts {
+		parent := ""
+		if account.Parent != nil {
+			parent = *account.Parent
+		}
+		if !below(parent) {
+			continue
+		}
+		accountList = append(accountList, map[string]interface{}{
+			"id":              core.StringNilMapper(account.ID),
+			"crn":             core.StringNilMapper(account.CRN),
+			"name":            core.StringNilMapper(account.Name),
+			"parent":          parent,
+			"enterprise_path": core.StringNilMapper(account.EnterprisePath),
+			"depth":           depthOf(parent),
+			"state":           core.StringNilMapper(account.State),
+		})
+	}
+
+	if root != "" {
+		d.SetId(root)
+	} else {
+		d.SetId(enterpriseID)
+	}
+	if err = d.Set("account_groups", groupList); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting account_groups %s", err))
+	}
+	if err = d.Set("accounts", accountList); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting accounts %s", err))
+	}
+
+	return nil
+}
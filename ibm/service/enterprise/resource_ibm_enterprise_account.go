@@ -13,6 +13,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
@@ -294,11 +295,42 @@ func resourceIbmEnterpriseAccountUpdate(context context.Context, d *schema.Resou
 			log.Printf("[DEBUG] UpdateAccountWithContext failed %s\n%s", err, response)
 			return diag.FromErr(err)
 		}
+		if d.HasChange("parent") {
+			_, err = waitForEnterpriseAccountMove(context, enterpriseManagementClient, d.Id(), d.Get("parent").(string), d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for account (%s) to move to parent %s: %s", d.Id(), d.Get("parent").(string), err))
+			}
+		}
 	}
 
 	return resourceIbmEnterpriseAccountRead(context, d, meta)
 }
 
+// waitForEnterpriseAccountMove waits until the account reports the new parent,
+// since the enterprise applies an account move asynchronously.
+func waitForEnterpriseAccountMove(context context.Context, enterpriseManagementClient *enterprisemanagementv1.EnterpriseManagementV1, accountID, parent string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"moving"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			getAccountOptions := &enterprisemanagementv1.GetAccountOptions{}
+			getAccountOptions.SetAccountID(accountID)
+			account, response, err := enterpriseManagementClient.GetAccountWithContext(context, getAccountOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting account: %s\n%s", err, response)
+			}
+			if account.Parent != nil && *account.Parent == parent {
+				return account, "done", nil
+			}
+			return account, "moving", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForStateContext(context)
+}
+
 func resourceIbmEnterpriseAccountDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	d.SetId("")
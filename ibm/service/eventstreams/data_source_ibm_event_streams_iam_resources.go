@@ -0,0 +1,177 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	eventStreamsIAMServiceName = "messagehub"
+	eventStreamsIAMWildcard    = "*"
+)
+
+// eventStreamsIAMResourceTypes maps the arguments of the data source to the
+// IAM resource types of Event Streams fine-grained policies.
+var eventStreamsIAMResourceTypes = []struct {
+	argument     string
+	resourceType string
+}{
+	{"topics", "topic"},
+	{"groups", "group"},
+	{"transaction_ids", "txnid"},
+	{"schemas", "schema"},
+}
+
+// DataSourceIBMEventStreamsIAMResources computes the resource attributes of
+// Event Streams fine-grained IAM policies. It does not call any API.
+func DataSourceIBMEventStreamsIAMResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMEventStreamsIAMResourcesRead,
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CRN of the Event Streams instance",
+			},
+			"topics": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Topic names, a name ending with * matches all topics with that prefix",
+			},
+			"groups": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Consumer group IDs, an ID ending with * matches all groups with that prefix",
+			},
+			"transaction_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Transactional IDs, an ID ending with * matches all transactional IDs with that prefix",
+			},
+			"schemas": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Schema names, a name ending with * matches all schemas with that prefix",
+			},
+			"include_cluster": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to include the cluster resource, that any client needs to connect to the instance",
+			},
+			"instance_guid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The GUID of the Event Streams instance, used as serviceInstance attribute",
+			},
+			"resources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The resources of the fine-grained policies, one policy per resource",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IAM resource type: cluster, topic, group, txnid or schema",
+						},
+						"resource": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the resource, empty for the cluster",
+						},
+						"resource_attributes": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The attributes to use in the resource_attributes blocks of an IAM policy",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of attribute",
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Value of attribute",
+									},
+									"operator": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Operator of attribute",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMEventStreamsIAMResourcesRead(d *schema.ResourceData, meta interface{}) error {
+	instanceCRN := d.Get("resource_instance_id").(string)
+	crnSegments := strings.Split(instanceCRN, ":")
+	if len(crnSegments) < 8 || crnSegments[0] != "crn" || crnSegments[4] != eventStreamsIAMServiceName || crnSegments[7] == "" {
+		return fmt.Errorf("[ERROR] %s is not the CRN of an Event Streams instance", instanceCRN)
+	}
+	instanceGUID := crnSegments[7]
+
+	resources := []map[string]interface{}{}
+	if d.Get("include_cluster").(bool) {
+		resources = append(resources, eventStreamsIAMResource(instanceGUID, "cluster", ""))
+	}
+	for _, t := range eventStreamsIAMResourceTypes {
+		for _, n := range d.Get(t.argument).([]interface{}) {
+			name, _ := n.(string)
+			if name == "" {
+				return fmt.Errorf("[ERROR] %s must not contain empty names", t.argument)
+			}
+			if strings.Contains(strings.TrimSuffix(name, eventStreamsIAMWildcard), eventStreamsIAMWildcard) {
+				return fmt.Errorf("[ERROR] %s %q can only use * at the end of the name", t.argument, name)
+			}
+			resources = append(resources, eventStreamsIAMResource(instanceGUID, t.resourceType, name))
+		}
+	}
+
+	d.SetId(instanceCRN)
+	d.Set("instance_guid", instanceGUID)
+	if err := d.Set("resources", resources); err != nil {
+		return fmt.Errorf("[ERROR] Error setting resources: %s", err)
+	}
+	return nil
+}
+
+// eventStreamsIAMResource returns the IAM policy attributes of a single Event
+// Streams resource. A name ending with * is matched as a prefix by IAM only
+// with the stringMatch operator, a "*" name matches all resources of the type.
+func eventStreamsIAMResource(instanceGUID, resourceType, name string) map[string]interface{} {
+	attributes := []map[string]interface{}{
+		{"name": "serviceName", "value": eventStreamsIAMServiceName, "operator": "stringEquals"},
+		{"name": "serviceInstance", "value": instanceGUID, "operator": "stringEquals"},
+		{"name": "resourceType", "value": resourceType, "operator": "stringEquals"},
+	}
+	if name != "" {
+		operator := "stringEquals"
+		if strings.HasSuffix(name, eventStreamsIAMWildcard) {
+			operator = "stringMatch"
+		}
+		attributes = append(attributes, map[string]interface{}{"name": "resource", "value": name, "operator": operator})
+	}
+	return map[string]interface{}{
+		"resource_type":       resourceType,
+		"resource":            name,
+		"resource_attributes": attributes,
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMEventStreamsIAMResourcesDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsIAMResourcesDataSourceConfigBasic(standardInstanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_event_streams_iam_resources.es_iam", "instance_guid"),
+					resource.TestCheckResourceAttr("data.ibm_event_streams_iam_resources.es_iam", "resources.#", "3"),
+					resource.TestCheckResourceAttr("data.ibm_event_streams_iam_resources.es_iam", "resources.0.resource_type", "cluster"),
+					resource.TestCheckResourceAttr("data.ibm_event_streams_iam_resources.es_iam", "resources.1.resource_type", "topic"),
+					resource.TestCheckResourceAttr("data.ibm_event_streams_iam_resources.es_iam", "resources.1.resource_attributes.3.operator", "stringMatch"),
+					resource.TestCheckResourceAttr("data.ibm_event_streams_iam_resources.es_iam", "resources.2.resource_type", "group"),
+					resource.TestCheckResourceAttr("data.ibm_event_streams_iam_resources.es_iam", "resources.2.resource_attributes.3.operator", "stringEquals"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsIAMResourcesDataSourceConfigBasic(instanceName string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "my_group" {
+		is_default=true
+	  }
+	data "ibm_resource_instance" "es_instance" {
+		resource_group_id = data.ibm_resource_group.my_group.id
+		name              = "%s"
+	}
+	data "ibm_event_streams_iam_resources" "es_iam" {
+		resource_instance_id = data.ibm_resource_instance.es_instance.id
+		topics               = ["orders-*"]
+		groups               = ["orders-consumer"]
+	}`, instanceName)
+}
@@ -4,14 +4,19 @@
 package iamidentity
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"log"
+	"net"
+	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 )
@@ -31,6 +36,12 @@ func ResourceIBMIAMAccountSettings() *schema.Resource {
 		DeleteContext: resourceIbmIamAccountSettingsDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIbmIamAccountSettingsAllowedIPAddressesCustomizeDiff(diff)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 			"include_history": {
 				Type:        schema.TypeBool,
@@ -57,6 +68,12 @@ func ResourceIBMIAMAccountSettings() *schema.Resource {
 				Optional:    true,
 				Description: "Defines the IP addresses and subnets from which IAM tokens can be created for the account.",
 			},
+			"applier_ip_addresses": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsIPAddress},
+				Description: "IP addresses that must keep access to the account, such as the address Terraform runs from. The plan fails if allowed_ip_addresses is set and does not include all of them.",
+			},
 			"entity_tag": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -138,6 +155,63 @@ func ResourceIBMIAMAccountSettings() *schema.Resource {
 	}
 }
 
+// resourceIbmIamAccountSettingsAllowedIPAddressesCustomizeDiff protects against
+// restricting the account to networks that no longer include the applier.
+func resourceIbmIamAccountSettingsAllowedIPAddressesCustomizeDiff(diff *schema.ResourceDiff) error {
+	if !diff.NewValueKnown("allowed_ip_addresses") || !diff.NewValueKnown("applier_ip_addresses") {
+		return nil
+	}
+	allowed := strings.TrimSpace(diff.Get("allowed_ip_addresses").(string))
+	if allowed == "" {
+		return nil
+	}
+	var missing []string
+	for _, ip := range diff.Get("applier_ip_addresses").([]interface{}) {
+		if ip == nil {
+			continue
+		}
+		if !allowedIPAddressesContain(allowed, ip.(string)) {
+			missing = append(missing, ip.(string))
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("[ERROR] allowed_ip_addresses does not include the applier IP addresses %s, applying it would lock them out of the account", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// allowedIPAddressesContain reports whether the comma separated list of IP
+// addresses, CIDR subnets and address ranges (first-last) contains ip.
+func allowedIPAddressesContain(allowed, ip string) bool {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+	for _, entry := range strings.Split(allowed, ",") {
+		entry = strings.TrimSpace(entry)
+		switch {
+		case strings.Contains(entry, "/"):
+			if _, subnet, err := net.ParseCIDR(entry); err == nil && subnet.Contains(addr) {
+				return true
+			}
+		case strings.Contains(entry, "-"):
+			bounds := strings.SplitN(entry, "-", 2)
+			first, last := net.ParseIP(strings.TrimSpace(bounds[0])), net.ParseIP(strings.TrimSpace(bounds[1]))
+			if first == nil || last == nil {
+				continue
+			}
+			if bytes.Compare(addr.To16(), first.To16()) >= 0 && bytes.Compare(addr.To16(), last.To16()) <= 0 {
+				return true
+			}
+		default:
+			if other := net.ParseIP(entry); other != nil && other.Equal(addr) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func ResourceIBMIAMAccountSettingsValidator() *validate.ResourceValidator {
 	validateSchema := make([]validate.ValidateSchema, 0)
 
@@ -5,6 +5,7 @@ package iamidentity_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -101,6 +102,29 @@ func TestAccIBMIAMAccountSettingsUpdate(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMAccountSettingsApplierIPLockout(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIbmIamAccountSettingsApplierIPConfig("10.0.0.0/24,192.168.1.1-192.168.1.20", "172.16.0.5"),
+				ExpectError: regexp.MustCompile("does not include the applier IP addresses 172.16.0.5"),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmIamAccountSettingsApplierIPConfig(allowedIPAddresses, applierIPAddress string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_account_settings" "iam_account_settings" {
+			allowed_ip_addresses = "%s"
+			applier_ip_addresses = ["%s"]
+		}
+	`, allowedIPAddresses, applierIPAddress)
+}
+
 func testAccCheckIbmIamAccountSettingsConfigBasic() string {
 	return `
 
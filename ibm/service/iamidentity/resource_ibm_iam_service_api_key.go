@@ -9,11 +9,15 @@ import (
 	"io/ioutil"
 	"log"
 	"strconv"
+	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	homedir "github.com/mitchellh/go-homedir"
 )
 
@@ -83,6 +87,54 @@ func ResourceIBMIAMServiceAPIKey() *schema.Resource {
 				Description:      "File where api key is to be stored",
 			},
 
+			"secrets_manager_secret": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "Arbitrary Secrets Manager secret where the API key value is stored instead of the state",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "Secrets Manager instance GUID",
+						},
+						"endpoint_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "public",
+							ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
+							Description:  "Endpoint Type. 'public' or 'private'",
+						},
+						"secret_group_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The secret group of the secret, the default secret group if not set",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The name of the secret",
+						},
+						"secret_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the secret",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the secret",
+						},
+					},
+				},
+			},
+
 			"crn": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -170,7 +222,13 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	d.SetId(*apiKey.ID)
-	d.Set("apikey", *apiKey.Apikey)
+	if _, ok := d.GetOk("secrets_manager_secret"); ok {
+		if err := exportServiceAPIKeyToSecretsManager(d, meta, apiKey); err != nil {
+			return err
+		}
+	} else {
+		d.Set("apikey", *apiKey.Apikey)
+	}
 
 	if keyfile, ok := d.GetOk("file"); ok {
 		if err := saveToFile(apiKey, keyfile.(string)); err != nil {
@@ -212,7 +270,7 @@ func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) e
 	if apiKey.AccountID != nil {
 		d.Set("account_id", *apiKey.AccountID)
 	}
-	if *apiKey.Apikey != "" {
+	if _, ok := d.GetOk("secrets_manager_secret"); !ok && *apiKey.Apikey != "" {
 		d.Set("apikey", *apiKey.Apikey)
 	}
 	if apiKey.CRN != nil {
@@ -297,6 +355,11 @@ func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{})
 	_, response, err := iamIdentityClient.GetAPIKey(getAPIKeyOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
+			// The secret of an API key that was deleted outside of Terraform
+			// is still deleted
+			if err := resourceIBMIAMServiceAPIKeyDeleteSecret(d, meta); err != nil {
+				return err
+			}
 			d.SetId("")
 			return nil
 		}
@@ -311,11 +374,40 @@ func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Error deleting Service API Key: %s\n%s", err, resp)
 	}
+
+	if err := resourceIBMIAMServiceAPIKeyDeleteSecret(d, meta); err != nil {
+		return err
+	}
 	d.SetId("")
 
 	return nil
 }
 
+// resourceIBMIAMServiceAPIKeyDeleteSecret deletes the Secrets Manager secret
+// that the API key was stored in, if any. A secret that is already deleted is
+// ignored.
+func resourceIBMIAMServiceAPIKeyDeleteSecret(d *schema.ResourceData, meta interface{}) error {
+	secrets, ok := d.GetOk("secrets_manager_secret")
+	if !ok {
+		return nil
+	}
+	secret := secrets.([]interface{})[0].(map[string]interface{})
+	secretID := secret["secret_id"].(string)
+	if secretID == "" {
+		return nil
+	}
+	secretsManagerClient, err := serviceAPIKeySecretsManagerClient(meta, secret)
+	if err != nil {
+		return err
+	}
+	deleteSecretOptions := secretsManagerClient.NewDeleteSecretOptions(secretsmanagerv1.DeleteSecretOptionsSecretTypeArbitraryConst, secretID)
+	resp, err := secretsManagerClient.DeleteSecret(deleteSecretOptions)
+	if err != nil && (resp == nil || resp.StatusCode != 404) {
+		return fmt.Errorf("[ERROR] Error deleting Secrets Manager secret of Service API Key: %s\n%s", err, resp)
+	}
+	return nil
+}
+
 func resourceIBMIAMServiceAPIKeyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
 	if err != nil {
@@ -364,3 +456,68 @@ func saveToFile(apiKey *iamidentityv1.APIKey, filePath string) error {
 
 	return err
 }
+
+// exportServiceAPIKeyToSecretsManager stores the value of a newly created API
+// key in an arbitrary secret, and sets the secret ID and CRN.
+func exportServiceAPIKeyToSecretsManager(d *schema.ResourceData, meta interface{}, apiKey *iamidentityv1.APIKey) error {
+	secret := d.Get("secrets_manager_secret").([]interface{})[0].(map[string]interface{})
+	secretsManagerClient, err := serviceAPIKeySecretsManagerClient(meta, secret)
+	if err != nil {
+		return err
+	}
+
+	secretResource := &secretsmanagerv1.SecretResource{
+		Name:        core.StringPtr(secret["name"].(string)),
+		Description: core.StringPtr(fmt.Sprintf("API key %s of %s", *apiKey.ID, d.Get("iam_service_id").(string))),
+		Payload:     apiKey.Apikey,
+	}
+	if groupID := secret["secret_group_id"].(string); groupID != "" {
+		secretResource.SecretGroupID = core.StringPtr(groupID)
+	}
+	metadata, err := secretsManagerClient.NewCollectionMetadata(secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretJSONConst, 1)
+	if err != nil {
+		return err
+	}
+	createSecretOptions := secretsManagerClient.NewCreateSecretOptions(secretsmanagerv1.CreateSecretOptionsSecretTypeArbitraryConst, metadata, []secretsmanagerv1.SecretResourceIntf{secretResource})
+	result, response, err := secretsManagerClient.CreateSecret(createSecretOptions)
+	if err != nil || result == nil || len(result.Resources) == 0 {
+		return fmt.Errorf("[ERROR] Error storing Service API Key in Secrets Manager: %s\n%s", err, response)
+	}
+	created := result.Resources[0].(*secretsmanagerv1.SecretResource)
+	secret["secret_id"] = *created.ID
+	secret["crn"] = core.StringNilMapper(created.CRN)
+	return d.Set("secrets_manager_secret", []interface{}{secret})
+}
+
+func serviceAPIKeySecretsManagerClient(meta interface{}, secret map[string]interface{}) (*secretsmanagerv1.SecretsManagerV1, error) {
+	bluemixSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	region := bluemixSession.Config.Region
+
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV1()
+	if err != nil {
+		return nil, err
+	}
+	rContollerClient, err := meta.(conns.ClientSession).ResourceControllerAPIV2()
+	if err != nil {
+		return nil, err
+	}
+
+	instanceID := secret["instance_id"].(string)
+	instanceData, err := rContollerClient.ResourceServiceInstanceV2().GetInstance(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	crnData := strings.Split(instanceData.Crn.String(), ":")
+	if crnData[4] != "secrets-manager" {
+		return nil, fmt.Errorf("[ERROR] Invalid or unsupported service Instance")
+	}
+	smEndpointURL := "https://" + instanceID + "." + region + ".secrets-manager.appdomain.cloud"
+	if secret["endpoint_type"].(string) == "private" {
+		smEndpointURL = "https://" + instanceID + ".private." + region + ".secrets-manager.appdomain.cloud"
+	}
+	secretsManagerClient.Service.Options.URL = conns.EnvFallBack([]string{"IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT"}, smEndpointURL)
+	return secretsManagerClient, nil
+}
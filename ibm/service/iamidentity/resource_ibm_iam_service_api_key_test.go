@@ -54,6 +54,30 @@ func TestAccIBMIAMServiceAPIKey_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMServiceAPIKey_SecretsManager(t *testing.T) {
+	var apiKey string
+	serviceName := fmt.Sprintf("terraform_iam_ser_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("terraform_iam_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMServiceAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMServiceAPIKeySecretsManager(serviceName, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMServiceAPIKeyExists("ibm_iam_service_api_key.testacc_apiKey", apiKey),
+					resource.TestCheckResourceAttr("ibm_iam_service_api_key.testacc_apiKey", "apikey", ""),
+					resource.TestCheckResourceAttr("ibm_iam_service_api_key.testacc_apiKey", "secrets_manager_secret.0.name", name),
+					resource.TestCheckResourceAttrSet("ibm_iam_service_api_key.testacc_apiKey", "secrets_manager_secret.0.secret_id"),
+					resource.TestCheckResourceAttrSet("ibm_iam_service_api_key.testacc_apiKey", "secrets_manager_secret.0.crn"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMIAMServiceAPIKey_import(t *testing.T) {
 	var apiKey string
 	serviceName := fmt.Sprintf("terraform_iam_ser_%d", acctest.RandIntRange(10, 100))
@@ -146,6 +170,23 @@ func testAccCheckIBMIAMServiceAPIKeyBasic(serviceName, name string) string {
 	`, serviceName, name)
 }
 
+func testAccCheckIBMIAMServiceAPIKeySecretsManager(serviceName, name string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_service_id" "serviceID" {
+			name = "%s"
+		  }
+		  resource "ibm_iam_service_api_key" "testacc_apiKey" {
+			name = "%s"
+			iam_service_id = ibm_iam_service_id.serviceID.iam_id
+			secrets_manager_secret {
+				instance_id = "%s"
+				name        = "%s"
+			}
+	  	}
+	`, serviceName, name, acc.SecretsManagerInstanceID, name)
+}
+
 func testAccCheckIBMIAMServiceAPIKeyUpdateWithSameName(serviceName, name string) string {
 	return fmt.Sprintf(`
 		
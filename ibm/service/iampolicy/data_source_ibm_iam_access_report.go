@@ -0,0 +1,388 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Data source to find who can access a resource, from the access policies of
+// the account
+func DataSourceIBMIAMAccessReport() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMIAMAccessReportRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_crn": {
+				Description: "The CRN of the resource",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"resource_group_id": {
+				Description: "The ID of the resource group of the resource, looked up for service instances if not set",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"account_id": {
+				Description: "The unique ID of an account",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"expand_access_groups": {
+				Description: "List the members of the access groups with policies on the resource as subjects",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"policies": {
+				Description: "The access policies that grant access to the resource",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"iam_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IAM ID of the user, service ID or trusted profile of the policy",
+						},
+						"access_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the access group of the policy",
+						},
+						"roles": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Role names of the policy definition",
+						},
+						"partial": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the policy is narrowed by attributes or tags that cannot be evaluated from the resource CRN, so that it may grant access to only part of the resource, or not at all",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the Policy",
+						},
+					},
+				},
+			},
+			"subjects": {
+				Description: "The users, service IDs and trusted profiles that have access to the resource, once for each policy",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"iam_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IAM ID of the subject",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the subject, user, service or profile",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the subject, for the members of access groups",
+						},
+						"access_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the access group the subject has access through, empty for a direct policy",
+						},
+						"policy_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the policy that grants access",
+						},
+						"roles": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Role names of the policy definition",
+						},
+						"partial": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the policy is partial",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIAMAccessReportRead(d *schema.ResourceData, meta interface{}) error {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	resourceCRN := d.Get("resource_crn").(string)
+	attributes, err := iamAccessReportCRNAttributes(resourceCRN)
+	if err != nil {
+		return err
+	}
+
+	var accountID string
+	if account, ok := d.GetOk("account_id"); ok && account.(string) != "" {
+		accountID = account.(string)
+	} else if attributes["accountId"] != "" {
+		accountID = attributes["accountId"]
+	} else {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return err
+		}
+		accountID = userDetails.UserAccount
+	}
+
+	resourceGroupID := d.Get("resource_group_id").(string)
+	if resourceGroupID == "" && attributes["serviceInstance"] != "" {
+		resourceGroupID = iamAccessReportResourceGroupID(meta, resourceCRN)
+	}
+	if resourceGroupID != "" {
+		attributes["resourceGroupId"] = resourceGroupID
+	}
+
+	listPoliciesOptions := &iampolicymanagementv1.ListPoliciesOptions{
+		AccountID: core.StringPtr(accountID),
+		Type:      core.StringPtr("access"),
+	}
+	policyList, resp, err := iamPolicyManagementClient.ListPolicies(listPoliciesOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing access policies: %s, %s", err, resp)
+	}
+
+	var iamAccessGroupsClient *iamaccessgroupsv2.IamAccessGroupsV2
+	expandAccessGroups := d.Get("expand_access_groups").(bool)
+	if expandAccessGroups {
+		iamAccessGroupsClient, err = meta.(conns.ClientSession).IAMAccessGroupsV2()
+		if err != nil {
+			return err
+		}
+	}
+	accessGroupMembers := map[string][]iamaccessgroupsv2.ListGroupMembersResponseMember{}
+
+	policies := make([]map[string]interface{}, 0)
+	subjects := make([]map[string]interface{}, 0)
+	for _, policy := range policyList.Policies {
+		if len(policy.Resources) == 0 || len(policy.Subjects) == 0 {
+			continue
+		}
+		matched, partial := iamAccessReportMatch(policy.Resources[0], attributes)
+		if !matched {
+			continue
+		}
+
+		roles := make([]string, len(policy.Roles))
+		for i, role := range policy.Roles {
+			if role.DisplayName != nil {
+				roles[i] = *role.DisplayName
+			} else {
+				roles[i] = *role.RoleID
+			}
+		}
+		iamID := *flex.GetSubjectAttribute("iam_id", policy.Subjects[0])
+		accessGroupID := *flex.GetSubjectAttribute("access_group_id", policy.Subjects[0])
+		p := map[string]interface{}{
+			"id":              *policy.ID,
+			"iam_id":          iamID,
+			"access_group_id": accessGroupID,
+			"roles":           roles,
+			"partial":         partial,
+		}
+		if policy.Description != nil {
+			p["description"] = *policy.Description
+		}
+		policies = append(policies, p)
+
+		if iamID != "" {
+			subjects = append(subjects, map[string]interface{}{
+				"iam_id":    iamID,
+				"type":      iamAccessReportSubjectType(iamID),
+				"policy_id": *policy.ID,
+				"roles":     roles,
+				"partial":   partial,
+			})
+			continue
+		}
+		if accessGroupID == "" || !expandAccessGroups {
+			continue
+		}
+		members, ok := accessGroupMembers[accessGroupID]
+		if !ok {
+			members, err = iamAccessReportGroupMembers(iamAccessGroupsClient, accessGroupID)
+			if err != nil {
+				return err
+			}
+			accessGroupMembers[accessGroupID] = members
+		}
+		for _, member := range members {
+			if member.IamID == nil {
+				continue
+			}
+			subjectType := iamAccessReportSubjectType(*member.IamID)
+			if member.Type != nil {
+				subjectType = *member.Type
+			}
+			subjects = append(subjects, map[string]interface{}{
+				"iam_id":          *member.IamID,
+				"type":            subjectType,
+				"name":            core.StringNilMapper(member.Name),
+				"access_group_id": accessGroupID,
+				"policy_id":       *policy.ID,
+				"roles":           roles,
+				"partial":         partial,
+			})
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", accountID, resourceCRN))
+	d.Set("account_id", accountID)
+	d.Set("resource_group_id", resourceGroupID)
+	d.Set("policies", policies)
+	d.Set("subjects", subjects)
+
+	return nil
+}
+
+// iamAccessReportCRNAttributes returns the policy resource attributes of the
+// resource CRN, crn:version:cname:ctype:service-name:location:scope:service-instance:resource-type:resource
+func iamAccessReportCRNAttributes(resourceCRN string) (map[string]string, error) {
+	parts := strings.Split(resourceCRN, ":")
+	if len(parts) != 10 || parts[0] != "crn" {
+		return nil, fmt.Errorf("[ERROR] Invalid resource CRN %s", resourceCRN)
+	}
+	attributes := map[string]string{
+		"serviceName":     parts[4],
+		"serviceInstance": parts[7],
+		"resourceType":    parts[8],
+		"resource":        parts[9],
+	}
+	if parts[5] != "global" {
+		attributes["region"] = parts[5]
+	}
+	if strings.HasPrefix(parts[6], "a/") {
+		attributes["accountId"] = strings.TrimPrefix(parts[6], "a/")
+	}
+	return attributes, nil
+}
+
+// iamAccessReportResourceGroupID returns the resource group ID of the service
+// instance of the resource CRN, or an empty string if it cannot be found.
+func iamAccessReportResourceGroupID(meta interface{}, resourceCRN string) string {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		log.Printf("[WARN] Error getting the resource controller client: %s", err)
+		return ""
+	}
+	parts := strings.Split(resourceCRN, ":")
+	instanceCRN := strings.Join(append(parts[:8], "", ""), ":")
+	instance, resp, err := rsConClient.GetResourceInstance(&rc.GetResourceInstanceOptions{
+		ID: &instanceCRN,
+	})
+	if err != nil || instance.ResourceGroupID == nil {
+		log.Printf("[WARN] Error retrieving the resource group of %s, policies on resource groups are reported as partial: %s\n%s", instanceCRN, err, resp)
+		return ""
+	}
+	return *instance.ResourceGroupID
+}
+
+// iamAccessReportMatch reports whether the policy resource can grant access to
+// the resource with the attributes, and whether the access is partial, as the
+// policy has attributes or tags that the resource attributes do not cover.
+func iamAccessReportMatch(resource iampolicymanagementv1.PolicyResource, attributes map[string]string) (matched bool, partial bool) {
+	partial = len(resource.Tags) > 0
+	for _, attribute := range resource.Attributes {
+		if attribute.Name == nil || attribute.Value == nil {
+			continue
+		}
+		if *attribute.Name == "serviceType" {
+			continue
+		}
+		value, ok := attributes[*attribute.Name]
+		if !ok || value == "" {
+			partial = true
+			continue
+		}
+		operator := ""
+		if attribute.Operator != nil {
+			operator = *attribute.Operator
+		}
+		switch operator {
+		case "", "stringEquals":
+			if value != *attribute.Value {
+				return false, false
+			}
+		case "stringMatch":
+			pattern := "^" + strings.Replace(regexp.QuoteMeta(*attribute.Value), `\*`, ".*", -1) + "$"
+			if !regexp.MustCompile(pattern).MatchString(value) {
+				return false, false
+			}
+		default:
+			partial = true
+		}
+	}
+	return true, partial
+}
+
+func iamAccessReportSubjectType(iamID string) string {
+	switch {
+	case strings.HasPrefix(iamID, "iam-ServiceId-"):
+		return "service"
+	case strings.HasPrefix(iamID, "iam-Profile-"):
+		return "profile"
+	}
+	return "user"
+}
+
+func iamAccessReportGroupMembers(iamAccessGroupsClient *iamaccessgroupsv2.IamAccessGroupsV2, accessGroupID string) ([]iamaccessgroupsv2.ListGroupMembersResponseMember, error) {
+	listAccessGroupMembersOptions := iamAccessGroupsClient.NewListAccessGroupMembersOptions(accessGroupID)
+	offset := int64(0)
+	limit := int64(100)
+	listAccessGroupMembersOptions.SetLimit(limit)
+	members, detailedResponse, err := iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error retrieving access group (%s) members: %s. API Response: %s", accessGroupID, err, detailedResponse)
+	}
+	allMembers := members.Members
+	totalMembers := flex.IntValue(members.TotalCount)
+	for len(allMembers) < totalMembers {
+		offset = offset + limit
+		listAccessGroupMembersOptions.SetOffset(offset)
+		members, detailedResponse, err = iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error retrieving access group (%s) members: %s. API Response: %s", accessGroupID, err, detailedResponse)
+		}
+		if len(members.Members) == 0 {
+			break
+		}
+		allMembers = append(allMembers, members.Members...)
+	}
+	return allMembers, nil
+}
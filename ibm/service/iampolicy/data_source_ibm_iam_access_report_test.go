@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIAMAccessReportDataSource_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccessReportDataSourceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_iam_access_report.report", "account_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_access_report.report", "policies.#"),
+					resource.TestCheckTypeSetElemAttrPair("data.ibm_iam_access_report.report", "policies.*.access_group_id", "ibm_iam_access_group.accgrp", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMAccessReportDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "instance" {
+			name     = "%[1]s"
+			service  = "kms"
+			plan     = "tiered-pricing"
+			location = "us-south"
+		}
+
+		resource "ibm_iam_access_group" "accgrp" {
+			name = "%[1]s"
+		}
+
+		resource "ibm_iam_access_group_policy" "policy" {
+			access_group_id = ibm_iam_access_group.accgrp.id
+			roles           = ["Viewer"]
+
+			resources {
+				service              = "kms"
+				resource_instance_id = ibm_resource_instance.instance.guid
+			}
+		}
+
+		data "ibm_iam_access_report" "report" {
+			resource_crn         = ibm_resource_instance.instance.crn
+			expand_access_groups = false
+			depends_on           = [ibm_iam_access_group_policy.policy]
+		}
+	`, name)
+}
@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"context"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The reconcile mode of the policy resources corrects policies modified
+// outside of Terraform, for example in the console. Read reports the resource
+// tags and the description of the policy even if they are not configured, and
+// a policy modified after the last apply is replaced with the configuration
+// even if the modification is not visible in the attributes of the resource.
+
+// iamPolicyReconcileRead sets the attributes of the reconcile mode, it is
+// called at the end of the Read of the policy resources.
+func iamPolicyReconcileRead(d *schema.ResourceData, policy *iampolicymanagementv1.Policy) {
+	if policy.LastModifiedAt != nil {
+		d.Set("last_modified_at", policy.LastModifiedAt.String())
+	}
+	if !d.Get("reconcile").(bool) {
+		return
+	}
+	d.Set("resource_tags", flex.FlattenPolicyResourceTags(policy.Resources))
+	if policy.Description == nil {
+		d.Set("description", "")
+	}
+}
+
+// iamPolicyReadReconciled reads the policy after a create or an update and
+// records its last modification time as reconciled.
+func iamPolicyReadReconciled(d *schema.ResourceData, meta interface{}, read schema.ReadFunc) error {
+	if err := read(d, meta); err != nil {
+		return err
+	}
+	d.Set("reconciled_at", d.Get("last_modified_at"))
+	return nil
+}
+
+// resourceIBMIAMPolicyReconcileCustomizeDiff plans an update of a policy in
+// reconcile mode that was modified since the last apply.
+func resourceIBMIAMPolicyReconcileCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	oldReconcile, newReconcile := diff.GetChange("reconcile")
+	if !oldReconcile.(bool) || !newReconcile.(bool) {
+		return nil
+	}
+	reconciledAt := diff.Get("reconciled_at").(string)
+	lastModifiedAt := diff.Get("last_modified_at").(string)
+	if reconciledAt == "" || reconciledAt == lastModifiedAt {
+		return nil
+	}
+	log.Printf("[INFO] IAM policy %s was modified at %s outside of Terraform, it will be reconciled", diff.Id(), lastModifiedAt)
+	return diff.SetNewComputed("reconciled_at")
+}
@@ -33,6 +33,7 @@ func ResourceIBMIAMAccessGroupPolicy() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		CustomizeDiff: resourceIBMIAMPolicyReconcileCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"access_group_id": {
@@ -185,6 +186,25 @@ func ResourceIBMIAMAccessGroupPolicy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"reconcile": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Replace the policy with the configuration when it was modified outside of Terraform",
+			},
+
+			"last_modified_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the policy was last modified",
+			},
+
+			"reconciled_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last modification time of the policy as of the last apply",
+			},
 		},
 	}
 }
@@ -270,7 +290,7 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 	}
 	d.SetId(fmt.Sprintf("%s/%s", accessGroupId, *accessGroupPolicy.ID))
 
-	return resourceIBMIAMAccessGroupPolicyRead(d, meta)
+	return iamPolicyReadReconciled(d, meta, resourceIBMIAMAccessGroupPolicyRead)
 }
 
 func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{}) error {
@@ -348,6 +368,8 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 		d.Set("description", *accessGroupPolicy.Description)
 	}
 
+	iamPolicyReconcileRead(d, accessGroupPolicy)
+
 	return nil
 }
 
@@ -357,7 +379,7 @@ func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return err
 	}
-	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") {
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") || d.HasChange("reconciled_at") {
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
 			return err
@@ -415,7 +437,7 @@ func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	return resourceIBMIAMAccessGroupPolicyRead(d, meta)
+	return iamPolicyReadReconciled(d, meta, resourceIBMIAMAccessGroupPolicyRead)
 }
 
 func resourceIBMIAMAccessGroupPolicyDelete(d *schema.ResourceData, meta interface{}) error {
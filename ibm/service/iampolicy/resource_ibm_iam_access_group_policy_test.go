@@ -11,6 +11,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -373,6 +374,37 @@ func testAccCheckIBMIAMAccessGroupPolicyDestroy(s *terraform.State) error {
 	return nil
 }
 
+func TestAccIBMIAMAccessGroupPolicy_reconcile(t *testing.T) {
+	var conf iampolicymanagementv1.Policy
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMAccessGroupPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccessGroupPolicyReconcile(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMAccessGroupPolicyExists("ibm_iam_access_group_policy.policy", conf),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policy.policy", "reconcile", "true"),
+					resource.TestCheckResourceAttrPair("ibm_iam_access_group_policy.policy", "reconciled_at", "ibm_iam_access_group_policy.policy", "last_modified_at"),
+					testAccIBMIAMAccessGroupPolicyModifyOutside("ibm_iam_access_group_policy.policy"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckIBMIAMAccessGroupPolicyReconcile(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policy.policy", "description", ""),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policy.policy", "roles.#", "1"),
+					resource.TestCheckResourceAttrPair("ibm_iam_access_group_policy.policy", "reconciled_at", "ibm_iam_access_group_policy.policy", "last_modified_at"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMIAMAccessGroupPolicyExists(n string, obj iampolicymanagementv1.Policy) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
@@ -408,6 +440,44 @@ func testAccCheckIBMIAMAccessGroupPolicyExists(n string, obj iampolicymanagement
 	}
 }
 
+// testAccIBMIAMAccessGroupPolicyModifyOutside adds a description and a role
+// to the policy, as if it was modified in the console.
+func testAccIBMIAMAccessGroupPolicyModifyOutside(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		iamPolicyManagementClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMPolicyManagementV1API()
+		if err != nil {
+			return err
+		}
+
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		policy, res, err := iamPolicyManagementClient.GetPolicy(iamPolicyManagementClient.NewGetPolicyOptions(parts[1]))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error retrieving Policy %s err: %s", parts[1], err)
+		}
+
+		updatePolicyOptions := iamPolicyManagementClient.NewUpdatePolicyOptions(
+			*policy.ID,
+			res.Headers.Get("ETag"),
+			*policy.Type,
+			policy.Subjects,
+			append(policy.Roles, iampolicymanagementv1.PolicyRole{RoleID: core.StringPtr("crn:v1:bluemix:public:iam::::role:Administrator")}),
+			policy.Resources,
+		)
+		updatePolicyOptions.SetDescription("Modified outside of Terraform")
+		_, _, err = iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		return err
+	}
+}
+
 func testAccCheckIBMIAMAccessGroupPolicyBasic(name string) string {
 	return fmt.Sprintf(`
 
@@ -755,3 +825,19 @@ func testAccCheckIBMIAMAccessGroupPolicyUpdateResourceTags(name string) string {
 		}
 	`, name)
 }
+
+func testAccCheckIBMIAMAccessGroupPolicyReconcile(name string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_access_group" "accgrp" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_access_group_policy" "policy" {
+			access_group_id = ibm_iam_access_group.accgrp.id
+			roles           = ["Viewer"]
+			reconcile       = true
+		}
+
+	`, name)
+}
@@ -35,6 +35,7 @@ func ResourceIBMIAMServicePolicy() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		CustomizeDiff: resourceIBMIAMPolicyReconcileCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"iam_service_id": {
@@ -189,6 +190,25 @@ func ResourceIBMIAMServicePolicy() *schema.Resource {
 				Optional:    true,
 				Description: "Description of the Policy",
 			},
+
+			"reconcile": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Replace the policy with the configuration when it was modified outside of Terraform",
+			},
+
+			"last_modified_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the policy was last modified",
+			},
+
+			"reconciled_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last modification time of the policy as of the last apply",
+			},
 		},
 	}
 }
@@ -306,7 +326,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 		d.SetId(fmt.Sprintf("%s/%s", iamID, *servicePolicy.ID))
 	}
 
-	return resourceIBMIAMServicePolicyRead(d, meta)
+	return iamPolicyReadReconciled(d, meta, resourceIBMIAMServicePolicyRead)
 }
 
 func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) error {
@@ -381,12 +401,14 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("description", *servicePolicy.Description)
 	}
 
+	iamPolicyReconcileRead(d, servicePolicy)
+
 	return nil
 }
 
 func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") {
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") || d.HasChange("reconciled_at") {
 
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
@@ -482,7 +504,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 
 	}
 
-	return resourceIBMIAMServicePolicyRead(d, meta)
+	return iamPolicyReadReconciled(d, meta, resourceIBMIAMServicePolicyRead)
 
 }
 
@@ -35,6 +35,7 @@ func ResourceIBMIAMTrustedProfilePolicy() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		CustomizeDiff: resourceIBMIAMPolicyReconcileCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"profile_id": {
@@ -189,6 +190,25 @@ func ResourceIBMIAMTrustedProfilePolicy() *schema.Resource {
 				Optional:    true,
 				Description: "Description of the Policy",
 			},
+
+			"reconcile": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Replace the policy with the configuration when it was modified outside of Terraform",
+			},
+
+			"last_modified_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the policy was last modified",
+			},
+
+			"reconciled_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last modification time of the policy as of the last apply",
+			},
 		},
 	}
 }
@@ -306,7 +326,7 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 		d.SetId(fmt.Sprintf("%s/%s", iamID, *trustedProfilePolicy.ID))
 	}
 
-	return resourceIBMIAMTrustedProfilePolicyRead(d, meta)
+	return iamPolicyReadReconciled(d, meta, resourceIBMIAMTrustedProfilePolicyRead)
 }
 
 func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interface{}) error {
@@ -381,12 +401,14 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 		d.Set("description", *trustedProfilePolicy.Description)
 	}
 
+	iamPolicyReconcileRead(d, trustedProfilePolicy)
+
 	return nil
 }
 
 func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") {
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") || d.HasChange("reconciled_at") {
 
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
@@ -482,7 +504,7 @@ func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta inter
 
 	}
 
-	return resourceIBMIAMTrustedProfilePolicyRead(d, meta)
+	return iamPolicyReadReconciled(d, meta, resourceIBMIAMTrustedProfilePolicyRead)
 
 }
 
@@ -33,6 +33,8 @@ const (
 	AUDITOR         = "auditor"
 	BILLINGMANANGER = "billingmanager"
 	DEVELOPER       = "developer"
+
+	userStatePending = "PENDING"
 )
 
 var viewOnly = []string{
@@ -228,6 +230,17 @@ func ResourceIBMIAMUserInvite() *schema.Resource {
 					},
 				},
 			},
+			"resend_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Changing this value resends the invitation to every user in users whose invitation is still pending",
+			},
+			"pending_users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Users in users that have not accepted the invitation yet",
+			},
 			"number_of_invited_users": {
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -245,6 +258,12 @@ func ResourceIBMIAMUserInvite() *schema.Resource {
 							Computed:    true,
 						},
 
+						"state": {
+							Description: "State of the user in the account, such as PENDING until the invitation is accepted",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
 						"user_policies": {
 							Type:     schema.TypeList,
 							Computed: true,
@@ -481,50 +500,10 @@ func resourceIBMIAMInviteUsers(d *schema.ResourceData, meta interface{}) error {
 	client := userManagement.UserInvite()
 
 	usersSet := d.Get("users").(*schema.Set)
-	usersList := flex.FlattenUsersSet(usersSet)
-	users := make([]v2.User, 0)
-	for _, user := range usersList {
-		users = append(users, v2.User{Email: user, AccountRole: MEMBER})
-	}
-	if len(users) == 0 {
-		return fmt.Errorf("[ERROR] Users email not provided")
-	}
-	var accessGroups = make([]string, 0)
-	if data, ok := d.GetOk("access_groups"); ok {
-		for _, accessGroup := range data.([]interface{}) {
-			accessGroups = append(accessGroups, fmt.Sprintf("%v", accessGroup))
-		}
-	}
-
-	var accessPolicies []v2.UserPolicy
-	if accessPolicyData, ok := d.GetOk("iam_policy"); ok {
-		accessPolicies, err = getPolicies(d, meta, accessPolicyData.([]interface{}))
-		if err != nil {
-			log.Println("IAM Acess policy: ", err.Error())
-			return err
-		}
-	}
-
-	inviteUserPayload := v2.UserInvite{}
-	log.Println(inviteUserPayload)
-	inviteUserPayload.Users = users
-	if len(accessGroups) != 0 {
-		inviteUserPayload.AccessGroup = accessGroups
-	}
-	if len(accessPolicies) != 0 {
-		inviteUserPayload.IAMPolicy = accessPolicies
-	}
-
-	if infraPermissions := getInfraPermissions(d, meta); len(infraPermissions) != 0 {
-		inviteUserPayload.InfrastructureRoles = &v2.InfraPermissions{Permissions: infraPermissions}
-	}
-	orgRoles, err := getCloudFoundryRoles(d, meta)
+	inviteUserPayload, err := userInvitePayload(d, meta, flex.FlattenUsersSet(usersSet))
 	if err != nil {
 		return err
 	}
-	if len(orgRoles) != 0 {
-		inviteUserPayload.OrganizationRoles = orgRoles
-	}
 
 	accountID, err := getAccountID(d, meta)
 	if err != nil {
@@ -563,8 +542,13 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 	}
 	users := make([]string, 0)
 	invitedUsers := make([]map[string]interface{}, 0, len(res))
+	pendingUsers := make([]string, 0)
+	managedUsers := d.Get("users").(*schema.Set)
 
 	for _, user := range res {
+		if user.State == userStatePending && managedUsers.Contains(user.Email) {
+			pendingUsers = append(pendingUsers, user.Email)
+		}
 
 		if user.AccountID != accountID {
 			users = append(users, user.Email)
@@ -652,6 +636,7 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 		}
 		userInfo := map[string]interface{}{
 			"user_id":       user.Email,
+			"state":         user.State,
 			"user_policies": userPolicies,
 			"access_groups": accGroupList,
 		}
@@ -660,6 +645,7 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 	//set the number of users in an account
 	d.Set("number_of_invited_users", len(res)-1)
 	d.Set("invited_users", invitedUsers)
+	d.Set("pending_users", pendingUsers)
 	return nil
 }
 
@@ -686,51 +672,10 @@ func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) e
 
 		//Update the added users
 		if len(added) > 0 {
-			users := make([]v2.User, 0)
-			for _, user := range added {
-				users = append(users, v2.User{Email: user, AccountRole: MEMBER})
-			}
-			if len(users) == 0 {
-				return fmt.Errorf("[ERROR] Users email not provided")
-			}
-
-			var accessPolicies []v2.UserPolicy
-			if accessPolicyData, ok := d.GetOk("iam_policy"); ok {
-				accessPolicies, err = getPolicies(d, meta, accessPolicyData.([]interface{}))
-				if err != nil {
-					log.Println("IAM Acess policy: ", err.Error())
-					return err
-				}
-			}
-
-			var accessGroups = make([]string, 0)
-			if data, ok := d.GetOk("access_groups"); ok {
-				for _, accessGroup := range data.([]interface{}) {
-					accessGroups = append(accessGroups, fmt.Sprintf("%v", accessGroup))
-				}
-			}
-
-			infraPermissions := getInfraPermissions(d, meta)
-			orgRoles, err := getCloudFoundryRoles(d, meta)
+			inviteUserPayload, err := userInvitePayload(d, meta, added)
 			if err != nil {
 				return err
 			}
-
-			inviteUserPayload := v2.UserInvite{}
-
-			inviteUserPayload.Users = users
-			if len(accessGroups) != 0 {
-				inviteUserPayload.AccessGroup = accessGroups
-			}
-			if len(accessPolicies) != 0 {
-				inviteUserPayload.IAMPolicy = accessPolicies
-			}
-			if len(infraPermissions) != 0 {
-				inviteUserPayload.InfrastructureRoles = &v2.InfraPermissions{Permissions: infraPermissions}
-			}
-			if len(orgRoles) != 0 {
-				inviteUserPayload.OrganizationRoles = orgRoles
-			}
 			_, InviteUserError := Client.InviteUsers(accountID, inviteUserPayload)
 			if InviteUserError != nil {
 				return InviteUserError
@@ -753,9 +698,108 @@ func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) e
 		}
 
 	}
+
+	if d.HasChange("resend_trigger") && !d.IsNewResource() {
+		if err := resendPendingUserInvites(d, meta); err != nil {
+			return err
+		}
+	}
 	return resourceIBMIAMGetUsers(d, meta)
 }
 
+// resendPendingUserInvites invites again the users whose invitation has not been
+// accepted yet. The service has no resend operation, so the pending user is removed
+// and invited again with the same access.
+func resendPendingUserInvites(d *schema.ResourceData, meta interface{}) error {
+	userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
+	if err != nil {
+		return err
+	}
+	Client := userManagement.UserInvite()
+
+	accountID, err := getAccountID(d, meta)
+	if err != nil {
+		return err
+	}
+	res, err := Client.ListUsers(accountID)
+	if err != nil {
+		return err
+	}
+
+	managedUsers := d.Get("users").(*schema.Set)
+	pending := make([]string, 0)
+	for _, userInfo := range res {
+		if userInfo.State == userStatePending && managedUsers.Contains(userInfo.Email) {
+			pending = append(pending, userInfo.Email)
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	// Build the invitation before removing the pending users, so that they are
+	// not left out of the account if the access cannot be resolved
+	inviteUserPayload, err := userInvitePayload(d, meta, pending)
+	if err != nil {
+		return err
+	}
+	for _, userInfo := range res {
+		if userInfo.State != userStatePending || !managedUsers.Contains(userInfo.Email) {
+			continue
+		}
+		if err := Client.RemoveUsers(accountID, userInfo.IamID); err != nil {
+			log.Println("Failed to remove pending user: ", userInfo.Email)
+			return err
+		}
+	}
+	_, InviteUserError := Client.InviteUsers(accountID, inviteUserPayload)
+	return InviteUserError
+}
+
+// userInvitePayload returns the invitation of the given users with the access
+// groups, policies and classic infrastructure and Cloud Foundry roles of the resource.
+func userInvitePayload(d *schema.ResourceData, meta interface{}, emails []string) (v2.UserInvite, error) {
+	inviteUserPayload := v2.UserInvite{}
+	users := make([]v2.User, 0, len(emails))
+	for _, user := range emails {
+		users = append(users, v2.User{Email: user, AccountRole: MEMBER})
+	}
+	if len(users) == 0 {
+		return inviteUserPayload, fmt.Errorf("[ERROR] Users email not provided")
+	}
+	inviteUserPayload.Users = users
+
+	if data, ok := d.GetOk("access_groups"); ok {
+		var accessGroups = make([]string, 0)
+		for _, accessGroup := range data.([]interface{}) {
+			accessGroups = append(accessGroups, fmt.Sprintf("%v", accessGroup))
+		}
+		if len(accessGroups) != 0 {
+			inviteUserPayload.AccessGroup = accessGroups
+		}
+	}
+	if accessPolicyData, ok := d.GetOk("iam_policy"); ok {
+		accessPolicies, err := getPolicies(d, meta, accessPolicyData.([]interface{}))
+		if err != nil {
+			log.Println("IAM Acess policy: ", err.Error())
+			return inviteUserPayload, err
+		}
+		if len(accessPolicies) != 0 {
+			inviteUserPayload.IAMPolicy = accessPolicies
+		}
+	}
+	if infraPermissions := getInfraPermissions(d, meta); len(infraPermissions) != 0 {
+		inviteUserPayload.InfrastructureRoles = &v2.InfraPermissions{Permissions: infraPermissions}
+	}
+	orgRoles, err := getCloudFoundryRoles(d, meta)
+	if err != nil {
+		return inviteUserPayload, err
+	}
+	if len(orgRoles) != 0 {
+		inviteUserPayload.OrganizationRoles = orgRoles
+	}
+	return inviteUserPayload, nil
+}
+
 func resourceIBMIAMRemoveUser(d *schema.ResourceData, meta interface{}) error {
 	userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
 	if err != nil {
@@ -776,6 +820,11 @@ func resourceIBMIAMRemoveUser(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return fmt.Errorf("[ERROR] User's IAM ID not found: %s", err.Error())
 		}
+		if IAMID == "" {
+			// The invitation was already removed or has expired.
+			log.Printf("[DEBUG] User %s is no longer in the account, skipping removal", user)
+			continue
+		}
 		Err := Client.RemoveUsers(accountID, IAMID)
 		if Err != nil {
 			return Err
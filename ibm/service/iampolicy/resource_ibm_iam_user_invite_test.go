@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package iampolicy_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM-Cloud/bluemix-go/api/usermanagement/usermanagementv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMUserInvite_Resend(t *testing.T) {
+	var userID string
+	email := fmt.Sprintf("tf-user-invite-%d@example.com", acctest.RandIntRange(10, 10000))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMUserInviteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMUserInviteResend(email, "initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMUserInvitePending(email, &userID),
+					resource.TestCheckResourceAttr("ibm_iam_user_invite.invite_user", "pending_users.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_user_invite.invite_user", "pending_users.0", email),
+					resource.TestCheckTypeSetElemNestedAttrs("ibm_iam_user_invite.invite_user", "invited_users.*", map[string]string{
+						"user_id": email,
+						"state":   "PENDING",
+					}),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMUserInviteResend(email, "resend"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMUserInviteResent(email, &userID),
+					resource.TestCheckResourceAttr("ibm_iam_user_invite.invite_user", "pending_users.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_user_invite.invite_user", "pending_users.0", email),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMIAMUserInviteFind(email string) (*usermanagementv2.UserInfo, error) {
+	userManagement, err := acc.TestAccProvider.Meta().(conns.ClientSession).UserManagementAPI()
+	if err != nil {
+		return nil, err
+	}
+	userDetails, err := acc.TestAccProvider.Meta().(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil, err
+	}
+	users, err := userManagement.UserInvite().ListUsers(userDetails.UserAccount)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if user.Email == email {
+			return &user, nil
+		}
+	}
+	return nil, nil
+}
+
+func testAccCheckIBMIAMUserInvitePending(email string, userID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		user, err := testAccIBMIAMUserInviteFind(email)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return fmt.Errorf("User %s was not invited", email)
+		}
+		if user.State != "PENDING" {
+			return fmt.Errorf("User %s is in state %s, expected PENDING", email, user.State)
+		}
+		*userID = user.ID
+		return nil
+	}
+}
+
+// testAccCheckIBMIAMUserInviteResent checks that the pending user was invited
+// again, the new invitation has a new user ID.
+func testAccCheckIBMIAMUserInviteResent(email string, userID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		previous := *userID
+		if err := testAccCheckIBMIAMUserInvitePending(email, userID)(s); err != nil {
+			return err
+		}
+		if *userID == previous {
+			return fmt.Errorf("The invitation of user %s was not resent", email)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMIAMUserInviteDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_user_invite" {
+			continue
+		}
+		for key, email := range rs.Primary.Attributes {
+			if !strings.HasPrefix(key, "users.") || key == "users.#" {
+				continue
+			}
+			user, err := testAccIBMIAMUserInviteFind(email)
+			if err != nil {
+				return err
+			}
+			if user != nil {
+				return fmt.Errorf("User %s is still in the account", email)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMIAMUserInviteResend(email, resendTrigger string) string {
+	return fmt.Sprintf(`
+	resource "ibm_iam_user_invite" "invite_user" {
+		users          = ["%s"]
+		resend_trigger = "%s"
+	}
+	`, email, resendTrigger)
+}
@@ -33,6 +33,7 @@ func ResourceIBMIAMUserPolicy() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		CustomizeDiff: resourceIBMIAMPolicyReconcileCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 
 			"ibm_id": {
@@ -179,6 +180,25 @@ func ResourceIBMIAMUserPolicy() *schema.Resource {
 				Optional:    true,
 				Description: "Description of the Policy",
 			},
+
+			"reconcile": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Replace the policy with the configuration when it was modified outside of Terraform",
+			},
+
+			"last_modified_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the policy was last modified",
+			},
+
+			"reconciled_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last modification time of the policy as of the last apply",
+			},
 		},
 	}
 }
@@ -274,7 +294,7 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 	}
 	d.SetId(fmt.Sprintf("%s/%s", userEmail, *userPolicy.ID))
 
-	return resourceIBMIAMUserPolicyRead(d, meta)
+	return iamPolicyReadReconciled(d, meta, resourceIBMIAMUserPolicyRead)
 }
 
 func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) error {
@@ -348,6 +368,8 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 	if userPolicy.Description != nil {
 		d.Set("description", *userPolicy.Description)
 	}
+	iamPolicyReconcileRead(d, userPolicy)
+
 	return nil
 }
 
@@ -356,7 +378,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return err
 	}
-	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") {
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") || d.HasChange("reconciled_at") {
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
 			return err
@@ -431,7 +453,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 			return fmt.Errorf("[ERROR] Error updating user policy: %s, %s", err, resp)
 		}
 	}
-	return resourceIBMIAMUserPolicyRead(d, meta)
+	return iamPolicyReadReconciled(d, meta, resourceIBMIAMUserPolicyRead)
 }
 
 func resourceIBMIAMUserPolicyDelete(d *schema.ResourceData, meta interface{}) error {
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"strings"
 	"time"
 
@@ -67,7 +66,7 @@ func ResourceIBMContainerCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -720,7 +719,7 @@ func resourceIBMContainerClusterCreate(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
-	d.SetId(cls.ID)
+	flex.TrackCreatedID(d, cls.ID)
 
 	targetEnvV2, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
@@ -735,12 +734,12 @@ func resourceIBMContainerClusterCreate(d *schema.ResourceData, meta interface{})
 
 	_, err = waitForClusterMasterAvailable(d, meta)
 	if err != nil {
-		return err
+		return flex.TrackedCreateError(d, "cluster", err)
 	}
 	if d.Get("wait_till").(string) == oneWorkerNodeReady {
 		_, err = waitForClusterOneWorkerAvailable(d, meta)
 		if err != nil {
-			return err
+			return flex.TrackedCreateError(d, "cluster", err)
 		}
 	}
 	d.Set("force_delete_storage", d.Get("force_delete_storage").(bool))
@@ -1290,8 +1289,8 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
+	v := flex.EnvTags(meta)
+	if d.HasChange("tags") || len(v) > 0 {
 		oldList, newList := d.GetChange("tags")
 		cluster, err := clusterAPI.Find(clusterID, targetEnv)
 		if err != nil {
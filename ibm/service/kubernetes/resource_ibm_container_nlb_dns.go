@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
 	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -21,6 +23,7 @@ func ResourceIBMContainerNlbDns() *schema.Resource {
 		ReadContext:   resourceIbmContainerNlbDnsRead,
 		UpdateContext: resourceIbmContainerNlbDnsUpdate,
 		DeleteContext: resourceIbmContainerNlbDnsDelete,
+		CustomizeDiff: resourceIbmContainerNlbDnsNlbHostDiff,
 
 		Schema: map[string]*schema.Schema{
 			"cluster": {
@@ -30,14 +33,29 @@ func ResourceIBMContainerNlbDns() *schema.Resource {
 				Description: "The name or ID of the cluster. To list the clusters that you have access to, use the `GET /v1/clusters` API or run `ibmcloud ks cluster ls`.",
 			},
 			"nlb_host": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The NLB subdomain. Computed when a new subdomain is created for lb_hostname.",
 			},
 			"nlb_ips": {
-				Type:     schema.TypeSet,
-				Required: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"nlb_ips", "lb_hostname"},
+				Description:  "The NLB IPs registered with the subdomain. IPs that are registered or unregistered outside of Terraform are reconciled on the next apply.",
+			},
+			"lb_hostname": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"nlb_ips", "lb_hostname"},
+				Description:  "The hostname of the VPC load balancer of the subdomain. A new subdomain is created for it if nlb_host is not set.",
+			},
+			"cert_regeneration_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Any change of this value regenerates the TLS certificate of the subdomain.",
 			},
 			"nlb_dns_type": {
 				Type:     schema.TypeString,
@@ -56,8 +74,12 @@ func ResourceIBMContainerNlbDns() *schema.Resource {
 				Computed: true,
 			},
 			"nlb_type": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "The type of the subdomain that is created for lb_hostname, public or private.",
 			},
 			"secret_namespace": {
 				Type:     schema.TypeString,
@@ -73,12 +95,58 @@ func ResourceIBMContainerNlbDns() *schema.Resource {
 	}
 }
 
+// resourceIbmContainerNlbDnsNlbHostDiff rejects nlb_ips without nlb_host,
+// since the IPs can only be registered with an existing subdomain.
+func resourceIbmContainerNlbDnsNlbHostDiff(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+	if !config.GetAttr("nlb_ips").IsNull() && config.GetAttr("nlb_host").IsNull() {
+		return fmt.Errorf("[ERROR] nlb_host must be set with nlb_ips")
+	}
+	return nil
+}
+
 func resourceIbmContainerNlbDnsCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if lbHostname, ok := d.GetOk("lb_hostname"); ok {
+		cluster := d.Get("cluster").(string)
+		if nlbHost, ok := d.GetOk("nlb_host"); ok {
+			replaceLBHostnameOptions := &kubernetesserviceapiv1.ReplaceLBHostnameOptions{}
+			replaceLBHostnameOptions.SetCluster(cluster)
+			replaceLBHostnameOptions.SetNlbSubdomain(nlbHost.(string))
+			replaceLBHostnameOptions.SetLbHostname(lbHostname.(string))
+			replaceLBHostnameOptions.SetHeaders(nlbDnsHeaders(d))
+			_, response, err := satClient.ReplaceLBHostnameWithContext(context, replaceLBHostnameOptions)
+			if err != nil {
+				log.Printf("[DEBUG] ReplaceLBHostnameWithContext failed %s\n%s", err, response)
+				return diag.FromErr(fmt.Errorf("ReplaceLBHostnameWithContext failed %s\n%s", err, response))
+			}
+		} else {
+			createNlbDNSOptions := &kubernetesserviceapiv1.CreateNlbDNSOptions{}
+			createNlbDNSOptions.SetCluster(cluster)
+			createNlbDNSOptions.SetLbHostname(lbHostname.(string))
+			if nlbType, ok := d.GetOk("nlb_type"); ok {
+				createNlbDNSOptions.SetType(nlbType.(string))
+			}
+			createNlbDNSOptions.SetHeaders(nlbDnsHeaders(d))
+			nlbConfig, response, err := satClient.CreateNlbDNSWithContext(context, createNlbDNSOptions)
+			if err != nil || nlbConfig == nil || nlbConfig.NlbSubdomain == nil {
+				log.Printf("[DEBUG] CreateNlbDNSWithContext failed %s\n%s", err, response)
+				return diag.FromErr(fmt.Errorf("CreateNlbDNSWithContext failed %s\n%s", err, response))
+			}
+			d.Set("nlb_host", *nlbConfig.NlbSubdomain)
+		}
+		d.SetId(cluster)
+
+		return resourceIbmContainerNlbDnsRead(context, d, meta)
+	}
+
 	registerDNSWithIPOptions := &kubernetesserviceapiv1.UpdateDNSWithIPOptions{}
 	registerDNSWithIPOptions.SetIdOrName(d.Get("cluster").(string))
 
@@ -120,30 +188,60 @@ func resourceIbmContainerNlbDnsRead(context context.Context, d *schema.ResourceD
 		return diag.FromErr(fmt.Errorf("[ERROR] Error Listing NLB DNS (%s): %s", d.Id(), err))
 	}
 
-	if nlbData != nil {
-		for _, nlbConfig := range nlbData {
-			if err = d.Set("cluster", d.Id()); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting cluster: %s", err))
-			}
-			if err = d.Set("nlb_dns_type", nlbConfig.Nlb.DnsType); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_dns_type: %s", err))
-			}
-			if err = d.Set("nlb_host", nlbConfig.Nlb.NlbSubdomain); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_host: %s", err))
-			}
-			if err = d.Set("nlb_ssl_secret_name", nlbConfig.SecretName); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_ssl_secret_name: %s", err))
-			}
-			if err = d.Set("nlb_ssl_secret_status", nlbConfig.SecretStatus); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_ssl_secret_status: %s", err))
-			}
-			if err = d.Set("nlb_type", nlbConfig.Nlb.Type); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_type: %s", err))
-			}
-			if err = d.Set("secret_namespace", nlbConfig.Nlb.SecretNamespace); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting secret_namespace: %s", err))
+	var nlbConfig *containerv2.NlbVPCListConfig
+	nlbHost := d.Get("nlb_host").(string)
+	for i := range nlbData {
+		if nlbHost == "" || nlbData[i].Nlb.NlbSubdomain == nlbHost {
+			nlbConfig = &nlbData[i]
+			break
+		}
+	}
+	if nlbConfig == nil {
+		log.Printf("[WARN] NLB subdomain %s of cluster %s not found, removing from state", nlbHost, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("cluster", d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting cluster: %s", err))
+	}
+	if err = d.Set("nlb_dns_type", nlbConfig.Nlb.DnsType); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_dns_type: %s", err))
+	}
+	if err = d.Set("nlb_host", nlbConfig.Nlb.NlbSubdomain); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_host: %s", err))
+	}
+	if err = d.Set("nlb_monitor_state", nlbConfig.Nlb.NlbMonitorState); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_monitor_state: %s", err))
+	}
+	if err = d.Set("nlb_ssl_secret_name", nlbConfig.SecretName); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_ssl_secret_name: %s", err))
+	}
+	if err = d.Set("nlb_ssl_secret_status", nlbConfig.SecretStatus); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_ssl_secret_status: %s", err))
+	}
+	if err = d.Set("nlb_type", nlbConfig.Nlb.Type); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_type: %s", err))
+	}
+	if err = d.Set("secret_namespace", nlbConfig.Nlb.SecretNamespace); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting secret_namespace: %s", err))
+	}
+	if _, ok := d.GetOk("lb_hostname"); ok {
+		if err = d.Set("lb_hostname", nlbConfig.Nlb.LbHostname); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting lb_hostname: %s", err))
+		}
+	} else {
+		// The registered IPs are read back so that IPs of replaced nodes, or
+		// IPs that were unregistered outside of Terraform, show up as a diff.
+		ips := make([]string, 0, len(nlbConfig.Nlb.NlbIPArray))
+		for _, ip := range nlbConfig.Nlb.NlbIPArray {
+			if ip, ok := ip.(string); ok {
+				ips = append(ips, ip)
 			}
 		}
+		if err = d.Set("nlb_ips", ips); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_ips: %s", err))
+		}
 	}
 
 	return nil
@@ -155,10 +253,43 @@ func resourceIbmContainerNlbDnsUpdate(context context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
+	nlbHost := d.Get("nlb_host").(string)
+
+	if d.HasChange("lb_hostname") {
+		if lbHostname, ok := d.GetOk("lb_hostname"); ok {
+			replaceLBHostnameOptions := &kubernetesserviceapiv1.ReplaceLBHostnameOptions{}
+			replaceLBHostnameOptions.SetCluster(d.Id())
+			replaceLBHostnameOptions.SetNlbSubdomain(nlbHost)
+			replaceLBHostnameOptions.SetLbHostname(lbHostname.(string))
+			replaceLBHostnameOptions.SetHeaders(nlbDnsHeaders(d))
+			_, response, err := satClient.ReplaceLBHostnameWithContext(context, replaceLBHostnameOptions)
+			if err != nil {
+				log.Printf("[DEBUG] ReplaceLBHostnameWithContext failed %s\n%s", err, response)
+				return diag.FromErr(fmt.Errorf("ReplaceLBHostnameWithContext failed %s\n%s", err, response))
+			}
+		}
+	}
+
+	if d.HasChange("cert_regeneration_trigger") {
+		regenerateCertOptions := &kubernetesserviceapiv1.RegenerateCertOptions{}
+		regenerateCertOptions.SetCluster(d.Id())
+		regenerateCertOptions.SetSubdomain(nlbHost)
+		regenerateCertOptions.SetHeaders(nlbDnsHeaders(d))
+		response, err := satClient.RegenerateCertWithContext(context, regenerateCertOptions)
+		if err != nil {
+			log.Printf("[DEBUG] RegenerateCertWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("RegenerateCertWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	// A subdomain of a load balancer has no NLB IPs to register
+	if _, ok := d.GetOk("lb_hostname"); ok {
+		return resourceIbmContainerNlbDnsRead(context, d, meta)
+	}
+
 	updateDNSWithIPOptions := &kubernetesserviceapiv1.UpdateDNSWithIPOptions{}
 
 	updateDNSWithIPOptions.SetIdOrName(d.Id())
-	nlbHost := d.Get("nlb_host").(string)
 
 	updateDNSWithIPOptions.NlbHost = flex.PtrToString(nlbHost)
 
@@ -209,6 +340,23 @@ func resourceIbmContainerNlbDnsDelete(context context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
+	// Only the load balancer is removed from the subdomain, the API has no call
+	// to delete the subdomain itself.
+	if lbHostname, ok := d.GetOk("lb_hostname"); ok {
+		removeLBHostnameOptions := &kubernetesserviceapiv1.RemoveLBHostnameOptions{}
+		removeLBHostnameOptions.SetCluster(d.Id())
+		removeLBHostnameOptions.SetNlbSubdomain(d.Get("nlb_host").(string))
+		removeLBHostnameOptions.SetLbHostname(lbHostname.(string))
+		removeLBHostnameOptions.SetHeaders(nlbDnsHeaders(d))
+		response, err := satClient.RemoveLBHostnameWithContext(context, removeLBHostnameOptions)
+		if err != nil {
+			log.Printf("[DEBUG] RemoveLBHostnameWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("RemoveLBHostnameWithContext failed %s\n%s", err, response))
+		}
+		d.SetId("")
+		return nil
+	}
+
 	unregisterDNSWithIPOptions := &kubernetesserviceapiv1.UnregisterDNSWithIPOptions{}
 	unregisterDNSWithIPOptions.SetIdOrName(d.Id())
 	if res, ok := d.GetOk("resource_group_id"); ok {
@@ -235,3 +383,11 @@ func resourceIbmContainerNlbDnsDelete(context context.Context, d *schema.Resourc
 
 	return nil
 }
+
+func nlbDnsHeaders(d *schema.ResourceData) map[string]string {
+	header := map[string]string{}
+	if res, ok := d.GetOk("resource_group_id"); ok {
+		header["X-Auth-Resource-Group"] = res.(string)
+	}
+	return header
+}
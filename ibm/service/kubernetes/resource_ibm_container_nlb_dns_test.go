@@ -5,6 +5,7 @@ package kubernetes_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -35,6 +36,70 @@ func TestAccIbmContainerNlbDnsBasic(t *testing.T) {
 	})
 }
 
+func TestAccIbmContainerNlbDnsNlbIpsWithoutNlbHost(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIbmContainerNlbDnsConfigNlbIpsWithoutNlbHost(),
+				ExpectError: regexp.MustCompile("nlb_host must be set with nlb_ips"),
+			},
+		},
+	})
+}
+
+func TestAccIbmContainerNlbDnsLbHostname(t *testing.T) {
+	var conf kubernetesserviceapiv1.NlbVPCListConfig
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmContainerNlbDnsConfigLbHostname("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmContainerNlbDnsExists("ibm_container_nlb_dns.container_nlb_dns", conf),
+					resource.TestCheckResourceAttr("ibm_container_nlb_dns.container_nlb_dns", "cluster", acc.ClusterName),
+					resource.TestCheckResourceAttrSet("ibm_container_nlb_dns.container_nlb_dns", "nlb_host"),
+					resource.TestCheckResourceAttrSet("ibm_container_nlb_dns.container_nlb_dns", "lb_hostname"),
+				),
+			},
+			{
+				Config: testAccCheckIbmContainerNlbDnsConfigLbHostname("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_container_nlb_dns.container_nlb_dns", "cert_regeneration_trigger", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmContainerNlbDnsConfigLbHostname(trigger string) string {
+	return fmt.Sprintf(`
+
+	  data "ibm_container_nlb_dns" "dns" {
+		cluster = "%s"
+	  }
+
+	  resource "ibm_container_nlb_dns" "container_nlb_dns" {
+		cluster                   = data.ibm_container_nlb_dns.dns.cluster
+		lb_hostname               = data.ibm_container_nlb_dns.dns.nlb_config.0.lb_hostname
+		nlb_type                  = "public"
+		cert_regeneration_trigger = "%s"
+	  }
+	`, acc.ClusterName, trigger)
+}
+
+func testAccCheckIbmContainerNlbDnsConfigNlbIpsWithoutNlbHost() string {
+	return fmt.Sprintf(`
+
+	  resource "ibm_container_nlb_dns" "container_nlb_dns" {
+		cluster = "%s"
+		nlb_ips = [ "168.1.1.1" ]
+	  }
+	`, acc.ClusterName)
+}
+
 func testAccCheckIbmContainerNlbDnsConfigBasic(clusterIps string) string {
 	return fmt.Sprintf(`
 
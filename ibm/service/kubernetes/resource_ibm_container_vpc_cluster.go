@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"strings"
 	"time"
 
@@ -46,7 +45,7 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -517,7 +516,7 @@ func resourceIBMContainerVpcClusterCreate(d *schema.ResourceData, meta interface
 		return err
 	}
 
-	d.SetId(cls.ID)
+	flex.TrackCreatedID(d, cls.ID)
 
 	if imageSecurityEnabled {
 		err = csClient.Clusters().EnableImageSecurityEnforcement(cls.ID, targetEnv)
@@ -531,19 +530,19 @@ func resourceIBMContainerVpcClusterCreate(d *schema.ResourceData, meta interface
 	case strings.ToLower(masterNodeReady):
 		_, err = waitForVpcClusterMasterAvailable(d, meta)
 		if err != nil {
-			return err
+			return flex.TrackedCreateError(d, "cluster", err)
 		}
 
 	case strings.ToLower(oneWorkerNodeReady):
 		_, err = waitForVpcClusterOneWorkerAvailable(d, meta)
 		if err != nil {
-			return err
+			return flex.TrackedCreateError(d, "cluster", err)
 		}
 
 	case strings.ToLower(ingressReady):
 		_, err = waitForVpcClusterIngressAvailable(d, meta)
 		if err != nil {
-			return err
+			return flex.TrackedCreateError(d, "cluster", err)
 		}
 
 	}
@@ -565,8 +564,8 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 
 	clusterID := d.Id()
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
+	v := flex.EnvTags(meta)
+	if d.HasChange("tags") || len(v) > 0 {
 		oldList, newList := d.GetChange("tags")
 		cluster, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
 		if err != nil {
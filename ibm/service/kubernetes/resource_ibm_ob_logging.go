@@ -318,15 +318,13 @@ func resourceIBMLoggingUpdate(d *schema.ResourceData, meta interface{}) error {
 		loggingUpdateModel.Instance = loggingID
 	}
 
-	if d.HasChange(obLoggingIngestionkey) {
-		key := d.Get(obLoggingIngestionkey).(string)
-		loggingUpdateModel.IngestionKey = key
-		hasChanged = true
-	}
+	// modifyConfig replaces the whole configuration, so the unchanged
+	// settings have to be sent as well, otherwise rotating the key would
+	// silently switch the agent back to the public endpoint.
+	loggingUpdateModel.IngestionKey = d.Get(obLoggingIngestionkey).(string)
+	loggingUpdateModel.PrivateEndpoint = d.Get(obLoggingPrivateEndpoint).(bool)
 
-	if d.HasChange(obLoggingPrivateEndpoint) {
-		endpoint := d.Get(obLoggingPrivateEndpoint).(bool)
-		loggingUpdateModel.PrivateEndpoint = endpoint
+	if d.HasChange(obLoggingIngestionkey) || d.HasChange(obLoggingPrivateEndpoint) {
 		hasChanged = true
 	}
 
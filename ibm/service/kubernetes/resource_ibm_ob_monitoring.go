@@ -290,15 +290,13 @@ func resourceIBMMonitoringUpdate(d *schema.ResourceData, meta interface{}) error
 		monitoringUpdateModel.Instance = monitoringID
 	}
 
-	if d.HasChange(obMonitoringIngestionkey) {
-		key := d.Get(obMonitoringIngestionkey).(string)
-		monitoringUpdateModel.IngestionKey = key
-		hasChanged = true
-	}
+	// modifyConfig replaces the whole configuration, so the unchanged
+	// settings have to be sent as well, otherwise rotating the key would
+	// silently switch the agent back to the public endpoint.
+	monitoringUpdateModel.IngestionKey = d.Get(obMonitoringIngestionkey).(string)
+	monitoringUpdateModel.PrivateEndpoint = d.Get(obMonitoringPrivateEndpoint).(bool)
 
-	if d.HasChange(obMonitoringPrivateEndpoint) {
-		endpoint := d.Get(obMonitoringPrivateEndpoint).(bool)
-		monitoringUpdateModel.PrivateEndpoint = endpoint
+	if d.HasChange(obMonitoringIngestionkey) || d.HasChange(obMonitoringPrivateEndpoint) {
 		hasChanged = true
 	}
 
@@ -19,7 +19,9 @@ import (
 )
 
 const (
-	PIDhcpID = "pi_dhcp_id"
+	PIDhcpID          = "pi_dhcp_id"
+	PIDhcpNetworkName = "network_name"
+	PIDhcpLeasesByMac = "leases_by_mac"
 )
 
 func DataSourceIBMPIDhcp() *schema.Resource {
@@ -48,6 +50,17 @@ func DataSourceIBMPIDhcp() *schema.Resource {
 				Computed:    true,
 				Description: "The DHCP Server private network",
 			},
+			PIDhcpNetworkName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the DHCP Server private network",
+			},
+			PIDhcpLeasesByMac: {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IPs of the DHCP Server PVM Instance leases, by MAC Address",
+			},
 			PIDhcpLeases: {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -92,17 +105,23 @@ func dataSourceIBMPIDhcpRead(ctx context.Context, d *schema.ResourceData, meta i
 	dhcpNetwork := dhcpServer.Network
 	if dhcpNetwork != nil {
 		d.Set(PIDhcpNetwork, *dhcpNetwork.ID)
+		if dhcpNetwork.Name != nil {
+			d.Set(PIDhcpNetworkName, *dhcpNetwork.Name)
+		}
 	}
 	dhcpLeases := dhcpServer.Leases
 	if dhcpLeases != nil {
 		leaseList := make([]map[string]string, len(dhcpLeases))
+		leaseMap := make(map[string]string, len(dhcpLeases))
 		for i, lease := range dhcpLeases {
 			leaseList[i] = map[string]string{
 				PIDhcpInstanceIp:  *lease.InstanceIP,
 				PIDhcpInstanceMac: *lease.InstanceMacAddress,
 			}
+			leaseMap[*lease.InstanceMacAddress] = *lease.InstanceIP
 		}
 		d.Set(PIDhcpLeases, leaseList)
+		d.Set(PIDhcpLeasesByMac, leaseMap)
 	}
 
 	return nil
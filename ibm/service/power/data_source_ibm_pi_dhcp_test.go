@@ -22,6 +22,8 @@ func TestAccIBMPIDhcpDataSourceBasic(t *testing.T) {
 				Config: testAccCheckIBMPIDhcpDataSourceConfig(),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.ibm_pi_dhcp.dhcp", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_dhcp.dhcp", "network_name"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_dhcp.dhcp", "leases_by_mac.%"),
 				),
 			},
 		},
@@ -14,6 +14,7 @@ import (
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -38,10 +39,12 @@ func ResourceIBMPINetworkPortAttach() *schema.Resource {
 			"port_id": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			helpers.PICloudInstanceId: {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			helpers.PIInstanceName: {
 				Type:        schema.TypeString,
@@ -51,6 +54,7 @@ func ResourceIBMPINetworkPortAttach() *schema.Resource {
 			helpers.PINetworkName: {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "Network Name - This is the subnet name  in the Cloud instance",
 			},
 			helpers.PINetworkPortDescription: {
@@ -63,6 +67,33 @@ func ResourceIBMPINetworkPortAttach() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			//Computed Attributes
+			"ipaddress": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IP address reserved by the network port",
+			},
+			"macaddress": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The MAC address of the network port",
+			},
+			"portid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the network port",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the network port",
+			},
+			"pvminstance": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to the instance the network port is attached to",
+			},
 		},
 	}
 
@@ -98,7 +129,11 @@ func resourceIBMPINetworkPortAttachCreate(ctx context.Context, d *schema.Resourc
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", cloudInstanceID, networkname, IBMPINetworkPortID))
 
-	_, err = isWaitForIBMPINetworkPortAttachAvailable(ctx, client, IBMPINetworkPortID, networkname, d.Timeout(schema.TimeoutCreate))
+	instanceID, err := piNetworkPortAttachInstanceID(ctx, sess, cloudInstanceID, instancename)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = isWaitForIBMPINetworkPortAttachAvailable(ctx, client, IBMPINetworkPortID, networkname, instanceID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -132,7 +167,9 @@ func resourceIBMPINetworkPortAttachRead(ctx context.Context, d *schema.ResourceD
 	d.Set("macaddress", networkdata.MacAddress)
 	d.Set("status", networkdata.Status)
 	d.Set("portid", networkdata.PortID)
-	d.Set("pvminstance", networkdata.PvmInstance.Href)
+	if networkdata.PvmInstance != nil {
+		d.Set("pvminstance", networkdata.PvmInstance.Href)
+	}
 	d.Set("public_ip", networkdata.ExternalIP)
 
 	return nil
@@ -140,7 +177,57 @@ func resourceIBMPINetworkPortAttachRead(ctx context.Context, d *schema.ResourceD
 
 func resourceIBMPINetworkPortAttachUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("Calling the attach update ")
-	return nil
+
+	if d.HasChanges(helpers.PIInstanceName, helpers.PINetworkPortDescription) {
+		sess, err := meta.(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		parts, err := flex.IdParts(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		cloudInstanceID := parts[0]
+		networkID := parts[1]
+		portID := parts[2]
+
+		instancename := d.Get(helpers.PIInstanceName).(string)
+		description := d.Get(helpers.PINetworkPortDescription).(string)
+		client := st.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
+
+		// Moving the port to another instance keeps its ip and mac address,
+		// the port has to be detached from the current instance first.
+		if d.HasChange(helpers.PIInstanceName) {
+			emptyPVM := ""
+			_, err = client.UpdatePort(networkID, portID, &models.NetworkPortUpdate{PvmInstanceID: &emptyPVM})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		body := &models.NetworkPortUpdate{
+			Description:   &description,
+			PvmInstanceID: &instancename,
+		}
+		_, err = client.UpdatePort(networkID, portID, body)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if d.HasChange(helpers.PIInstanceName) {
+			instanceID, err := piNetworkPortAttachInstanceID(ctx, sess, cloudInstanceID, instancename)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			_, err = isWaitForIBMPINetworkPortAttachAvailable(ctx, client, portID, networkID, instanceID, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceIBMPINetworkPortAttachRead(ctx, d, meta)
 }
 
 func resourceIBMPINetworkPortAttachDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -178,13 +265,26 @@ func resourceIBMPINetworkPortAttachDelete(ctx context.Context, d *schema.Resourc
 
 }
 
-func isWaitForIBMPINetworkPortAttachAvailable(ctx context.Context, client *st.IBMPINetworkClient, id string, networkname string, timeout time.Duration) (interface{}, error) {
+// piNetworkPortAttachInstanceID returns the ID of the instance to attach the
+// port to, the instance can be given by name or by ID.
+func piNetworkPortAttachInstanceID(ctx context.Context, sess *ibmpisession.IBMPISession, cloudInstanceID, instancename string) (string, error) {
+	instance, err := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID).Get(instancename)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error getting the instance %s to attach the network port to: %s", instancename, err)
+	}
+	if instance.PvmInstanceID == nil {
+		return instancename, nil
+	}
+	return *instance.PvmInstanceID, nil
+}
+
+func isWaitForIBMPINetworkPortAttachAvailable(ctx context.Context, client *st.IBMPINetworkClient, id string, networkname string, instanceID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Power Network (%s) that was created for Network Zone (%s) to be available.", id, networkname)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", helpers.PINetworkProvisioning},
 		Target:     []string{"ACTIVE"},
-		Refresh:    isIBMPINetworkPortAttachRefreshFunc(client, id, networkname),
+		Refresh:    isIBMPINetworkPortAttachRefreshFunc(client, id, networkname, instanceID),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Minute,
@@ -193,7 +293,7 @@ func isWaitForIBMPINetworkPortAttachAvailable(ctx context.Context, client *st.IB
 	return stateConf.WaitForStateContext(ctx)
 }
 
-func isIBMPINetworkPortAttachRefreshFunc(client *st.IBMPINetworkClient, id, networkname string) resource.StateRefreshFunc {
+func isIBMPINetworkPortAttachRefreshFunc(client *st.IBMPINetworkClient, id, networkname, instanceID string) resource.StateRefreshFunc {
 
 	log.Printf("Calling the IsIBMPINetwork Refresh Function....with the following id (%s) for network port and following id (%s) for network name and waiting for network to be READY", id, networkname)
 	return func() (interface{}, string, error) {
@@ -202,7 +302,9 @@ func isIBMPINetworkPortAttachRefreshFunc(client *st.IBMPINetworkClient, id, netw
 			return nil, "", err
 		}
 
-		if &network.PortID != nil && &network.PvmInstance.PvmInstanceID != nil {
+		// The port is only active once it is attached to the target instance, a
+		// moved port is still attached to the previous instance for a while
+		if network.PortID != nil && network.PvmInstance != nil && network.PvmInstance.PvmInstanceID == instanceID {
 			//if network.State == "available" {
 			log.Printf(" The port has been created with the following ip address and attached to an instance ")
 			return network, "ACTIVE", nil
@@ -0,0 +1,382 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	crPullTokenServiceName = "container-registry"
+	crPullTokenReaderRole  = "crn:v1:bluemix:public:iam::::serviceRole:Reader"
+	crPullTokenUsername    = "iamapikey"
+)
+
+func ResourceIBMCrPullToken() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCrPullTokenCreate,
+		ReadContext:   resourceIBMCrPullTokenRead,
+		UpdateContext: resourceIBMCrPullTokenUpdate,
+		DeleteContext: resourceIBMCrPullTokenDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the service ID that holds the pull credentials.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The description of the service ID and API key.",
+			},
+			"namespaces": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The namespaces that the credentials are allowed to pull images from.",
+			},
+			"service_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the service ID that holds the pull credentials.",
+			},
+			"iam_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IAM ID of the service ID.",
+			},
+			"apikey_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the API key used as pull credential.",
+			},
+			"apikey": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The API key used as pull credential.",
+			},
+			"policy_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the reader policies, keyed by namespace.",
+			},
+			"registry": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The registry domain that the credentials are valid for.",
+			},
+			"docker_config_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The Docker configuration JSON that can be used as the .dockerconfigjson of a Kubernetes image pull secret.",
+			},
+		},
+	}
+}
+
+func resourceIBMCrPullTokenCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	containerRegistryClient, err := meta.(conns.ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	createServiceIDOptions := &iamidentityv1.CreateServiceIDOptions{
+		Name:      &name,
+		AccountID: &userDetails.UserAccount,
+	}
+	if des, ok := d.GetOk("description"); ok {
+		createServiceIDOptions.Description = core.StringPtr(des.(string))
+	}
+	serviceID, response, err := iamIdentityClient.CreateServiceIDWithContext(context, createServiceIDOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateServiceIDWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] CreateServiceIDWithContext failed %s\n%s", err, response))
+	}
+	d.SetId(*serviceID.ID)
+	d.Set("service_id", serviceID.ID)
+	d.Set("iam_id", serviceID.IamID)
+
+	createAPIKeyOptions := &iamidentityv1.CreateAPIKeyOptions{
+		Name:        core.StringPtr(name),
+		IamID:       serviceID.IamID,
+		AccountID:   &userDetails.UserAccount,
+		Description: createServiceIDOptions.Description,
+		StoreValue:  core.BoolPtr(false),
+	}
+	apiKey, response, err := iamIdentityClient.CreateAPIKeyWithContext(context, createAPIKeyOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateAPIKeyWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] CreateAPIKeyWithContext failed %s\n%s", err, response))
+	}
+	d.Set("apikey_id", apiKey.ID)
+	d.Set("apikey", apiKey.Apikey)
+
+	registry, err := url.Parse(containerRegistryClient.GetServiceURL())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error parsing the container registry URL: %s", err))
+	}
+	dockerConfig, err := crPullTokenDockerConfig(registry.Host, *apiKey.Apikey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("registry", registry.Host)
+	d.Set("docker_config_json", dockerConfig)
+
+	policyIDs := map[string]string{}
+	for _, ns := range flex.ExpandStringList(d.Get("namespaces").(*schema.Set).List()) {
+		policyID, err := resourceIBMCrPullTokenCreatePolicy(context, meta, *serviceID.IamID, ns)
+		if err != nil {
+			d.Set("policy_ids", policyIDs)
+			return diag.FromErr(err)
+		}
+		policyIDs[ns] = policyID
+	}
+	d.Set("policy_ids", policyIDs)
+
+	return resourceIBMCrPullTokenRead(context, d, meta)
+}
+
+func resourceIBMCrPullTokenRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getServiceIDOptions := &iamidentityv1.GetServiceIDOptions{
+		ID: core.StringPtr(d.Id()),
+	}
+	serviceID, response, err := iamIdentityClient.GetServiceIDWithContext(context, getServiceIDOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetServiceIDWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] GetServiceIDWithContext failed %s\n%s", err, response))
+	}
+	d.Set("name", serviceID.Name)
+	d.Set("service_id", serviceID.ID)
+	d.Set("iam_id", serviceID.IamID)
+	if serviceID.Description != nil && *serviceID.Description != "" {
+		d.Set("description", serviceID.Description)
+	}
+
+	listPoliciesOptions := &iampolicymanagementv1.ListPoliciesOptions{
+		AccountID: &userDetails.UserAccount,
+		IamID:     serviceID.IamID,
+		Type:      core.StringPtr("access"),
+	}
+	policyList, response, err := iamPolicyManagementClient.ListPoliciesWithContext(context, listPoliciesOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListPoliciesWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] ListPoliciesWithContext failed %s\n%s", err, response))
+	}
+	policyIDs := map[string]string{}
+	namespaces := []string{}
+	for _, policy := range policyList.Policies {
+		if len(policy.Resources) == 0 ||
+			*flex.GetResourceAttribute("serviceName", policy.Resources[0]) != crPullTokenServiceName ||
+			*flex.GetResourceAttribute("resourceType", policy.Resources[0]) != "namespace" {
+			continue
+		}
+		ns := *flex.GetResourceAttribute("resource", policy.Resources[0])
+		policyIDs[ns] = *policy.ID
+		namespaces = append(namespaces, ns)
+	}
+	d.Set("policy_ids", policyIDs)
+	d.Set("namespaces", namespaces)
+
+	return nil
+}
+
+func resourceIBMCrPullTokenUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("namespaces") {
+		iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		policyIDs := d.Get("policy_ids").(map[string]interface{})
+		oldNamespaces, newNamespaces := d.GetChange("namespaces")
+		remove := oldNamespaces.(*schema.Set).Difference(newNamespaces.(*schema.Set))
+		add := newNamespaces.(*schema.Set).Difference(oldNamespaces.(*schema.Set))
+
+		for _, ns := range flex.ExpandStringList(remove.List()) {
+			policyID, ok := policyIDs[ns]
+			if !ok {
+				continue
+			}
+			response, err := iamPolicyManagementClient.DeletePolicyWithContext(context, iamPolicyManagementClient.NewDeletePolicyOptions(policyID.(string)))
+			if err != nil && (response == nil || response.StatusCode != 404) {
+				log.Printf("[DEBUG] DeletePolicyWithContext failed %s\n%s", err, response)
+				return diag.FromErr(fmt.Errorf("[ERROR] DeletePolicyWithContext failed %s\n%s", err, response))
+			}
+		}
+		for _, ns := range flex.ExpandStringList(add.List()) {
+			if _, err := resourceIBMCrPullTokenCreatePolicy(context, meta, d.Get("iam_id").(string), ns); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceIBMCrPullTokenRead(context, d, meta)
+}
+
+func resourceIBMCrPullTokenDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, policyID := range d.Get("policy_ids").(map[string]interface{}) {
+		response, err := iamPolicyManagementClient.DeletePolicyWithContext(context, iamPolicyManagementClient.NewDeletePolicyOptions(policyID.(string)))
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			log.Printf("[DEBUG] DeletePolicyWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] DeletePolicyWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	// Deleting the service ID also deletes its API keys.
+	deleteServiceIDOptions := &iamidentityv1.DeleteServiceIDOptions{
+		ID: core.StringPtr(d.Id()),
+	}
+	response, err := iamIdentityClient.DeleteServiceIDWithContext(context, deleteServiceIDOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteServiceIDWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] DeleteServiceIDWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceIBMCrPullTokenCreatePolicy grants the service ID the Reader role on
+// a single registry namespace, which is the minimum needed to pull images.
+func resourceIBMCrPullTokenCreatePolicy(context context.Context, meta interface{}, iamID, namespace string) (string, error) {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return "", err
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return "", err
+	}
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return "", err
+	}
+
+	subject := iampolicymanagementv1.PolicySubject{
+		Attributes: []iampolicymanagementv1.SubjectAttribute{
+			{
+				Name:  core.StringPtr("iam_id"),
+				Value: core.StringPtr(iamID),
+			},
+		},
+	}
+	resource := iampolicymanagementv1.PolicyResource{
+		Attributes: []iampolicymanagementv1.ResourceAttribute{
+			{
+				Name:     core.StringPtr("accountId"),
+				Value:    core.StringPtr(userDetails.UserAccount),
+				Operator: core.StringPtr("stringEquals"),
+			},
+			{
+				Name:     core.StringPtr("serviceName"),
+				Value:    core.StringPtr(crPullTokenServiceName),
+				Operator: core.StringPtr("stringEquals"),
+			},
+			{
+				Name:     core.StringPtr("region"),
+				Value:    core.StringPtr(bxSession.Config.Region),
+				Operator: core.StringPtr("stringEquals"),
+			},
+			{
+				Name:     core.StringPtr("resourceType"),
+				Value:    core.StringPtr("namespace"),
+				Operator: core.StringPtr("stringEquals"),
+			},
+			{
+				Name:     core.StringPtr("resource"),
+				Value:    core.StringPtr(namespace),
+				Operator: core.StringPtr("stringEquals"),
+			},
+		},
+	}
+	role := iampolicymanagementv1.PolicyRole{
+		RoleID: core.StringPtr(crPullTokenReaderRole),
+	}
+	createPolicyOptions := iamPolicyManagementClient.NewCreatePolicyOptions(
+		"access",
+		[]iampolicymanagementv1.PolicySubject{subject},
+		[]iampolicymanagementv1.PolicyRole{role},
+		[]iampolicymanagementv1.PolicyResource{resource},
+	)
+	policy, response, err := iamPolicyManagementClient.CreatePolicyWithContext(context, createPolicyOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreatePolicyWithContext failed %s\n%s", err, response)
+		return "", fmt.Errorf("[ERROR] Error creating pull policy for namespace %s: %s\n%s", namespace, err, response)
+	}
+	return *policy.ID, nil
+}
+
+func crPullTokenDockerConfig(registry, apikey string) (string, error) {
+	auth := map[string]interface{}{
+		"username": crPullTokenUsername,
+		"password": apikey,
+		"auth":     base64.StdEncoding.EncodeToString([]byte(crPullTokenUsername + ":" + apikey)),
+	}
+	config := map[string]interface{}{
+		"auths": map[string]interface{}{
+			registry: auth,
+		},
+	}
+	dockerConfig, err := json.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error building the Docker configuration: %s", err)
+	}
+	return string(dockerConfig), nil
+}
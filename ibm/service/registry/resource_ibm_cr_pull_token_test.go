@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCrPullTokenBasic(t *testing.T) {
+	namespace := fmt.Sprintf("tf-pull-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-pull-token-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCrPullTokenConfig(namespace, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cr_pull_token.pull_token", "name", name),
+					resource.TestCheckResourceAttr("ibm_cr_pull_token.pull_token", "namespaces.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cr_pull_token.pull_token", "policy_ids.%", "1"),
+					resource.TestCheckResourceAttrSet("ibm_cr_pull_token.pull_token", "iam_id"),
+					resource.TestCheckResourceAttrSet("ibm_cr_pull_token.pull_token", "registry"),
+					resource.TestCheckResourceAttrSet("ibm_cr_pull_token.pull_token", "docker_config_json"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCrPullTokenConfig(namespace, name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cr_namespace" "cr_namespace" {
+			name = "%s"
+		}
+
+		resource "ibm_cr_pull_token" "pull_token" {
+			name       = "%s"
+			namespaces = [ibm_cr_namespace.cr_namespace.name]
+		}
+	`, namespace, name)
+}
@@ -0,0 +1,323 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const globalCatalogPageLimit = 200
+
+func DataSourceIBMGlobalCatalogService() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMGlobalCatalogServiceRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the service in the global catalog, for example cloud-object-storage",
+			},
+			"plan": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the plan with this name",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the plans that can be deployed in this location",
+			},
+			"include_pricing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to return the pricing metrics of the plans",
+			},
+			"country": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "USA",
+				Description: "The country code of the prices, for example USA or DEU",
+			},
+			"service_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The global catalog ID of the service",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The display name of the service",
+			},
+			"plans": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The plans of the service",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The global catalog ID of the plan",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the plan",
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The display name of the plan",
+						},
+						"pricing_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The pricing type of the plan, for example free or paid",
+						},
+						"locations": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The locations where the plan can be deployed",
+						},
+						"pricing": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The pricing metrics of the plan, set if include_pricing is true",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The ID of the metric",
+									},
+									"charge_unit_name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the charge unit",
+									},
+									"charge_unit_display_name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The display name of the charge unit",
+									},
+									"currency": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The currency of the prices",
+									},
+									"prices": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "The price of each quantity tier",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"quantity_tier": {
+													Type:        schema.TypeInt,
+													Computed:    true,
+													Description: "The quantity from which the price applies",
+												},
+												"price": {
+													Type:        schema.TypeFloat,
+													Computed:    true,
+													Description: "The price per charge unit",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMGlobalCatalogServiceRead(d *schema.ResourceData, meta interface{}) error {
+	globalCatalogClient, err := meta.(conns.ClientSession).GlobalCatalogV1()
+	if err != nil {
+		return err
+	}
+	name := d.Get("name").(string)
+
+	listCatalogEntriesOptions := &globalcatalogv1.ListCatalogEntriesOptions{
+		Q: core.StringPtr(fmt.Sprintf("name:%s active:true", name)),
+	}
+	entries, response, err := globalCatalogClient.ListCatalogEntries(listCatalogEntriesOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error searching the global catalog for service %s: %s\n%s", name, err, response)
+	}
+	var service *globalcatalogv1.CatalogEntry
+	for i, entry := range entries.Resources {
+		if entry.Name != nil && *entry.Name == name {
+			service = &entries.Resources[i]
+			break
+		}
+	}
+	if service == nil || service.ID == nil {
+		return fmt.Errorf("[ERROR] No service with name %s found in the global catalog", name)
+	}
+
+	plans, err := globalCatalogChildren(globalCatalogClient, *service.ID, "plan")
+	if err != nil {
+		return err
+	}
+
+	planName := d.Get("plan").(string)
+	location := d.Get("location").(string)
+	includePricing := d.Get("include_pricing").(bool)
+	country := d.Get("country").(string)
+	planList := make([]map[string]interface{}, 0, len(plans))
+	for _, plan := range plans {
+		if plan.ID == nil || plan.Name == nil || (planName != "" && *plan.Name != planName) {
+			continue
+		}
+		deployments, err := globalCatalogChildren(globalCatalogClient, *plan.ID, "deployment")
+		if err != nil {
+			return err
+		}
+		locations := []string{}
+		for _, deployment := range deployments {
+			if deployment.Metadata != nil && deployment.Metadata.Deployment != nil && deployment.Metadata.Deployment.Location != nil {
+				locations = append(locations, *deployment.Metadata.Deployment.Location)
+			}
+		}
+		sort.Strings(locations)
+		if location != "" {
+			if !globalCatalogContains(locations, location) {
+				continue
+			}
+			locations = []string{location}
+		}
+
+		p := map[string]interface{}{
+			"id":           *plan.ID,
+			"name":         *plan.Name,
+			"display_name": globalCatalogDisplayName(plan),
+			"locations":    locations,
+		}
+		if plan.Metadata != nil && plan.Metadata.Pricing != nil && plan.Metadata.Pricing.Type != nil {
+			p["pricing_type"] = *plan.Metadata.Pricing.Type
+		}
+		if includePricing {
+			getPricingOptions := &globalcatalogv1.GetPricingOptions{
+				ID: plan.ID,
+			}
+			pricing, response, err := globalCatalogClient.GetPricing(getPricingOptions)
+			if err != nil {
+				if response == nil || response.StatusCode != 404 {
+					return fmt.Errorf("[ERROR] Error getting pricing of plan %s: %s\n%s", *plan.Name, err, response)
+				}
+				// Plans without global pricing, for example free plans
+				log.Printf("[DEBUG] No pricing found for plan %s", *plan.Name)
+			} else {
+				if pricing.Type != nil {
+					p["pricing_type"] = *pricing.Type
+				}
+				p["pricing"] = flattenGlobalCatalogPricingMetrics(pricing.Metrics, country)
+			}
+		}
+		planList = append(planList, p)
+	}
+
+	d.SetId(*service.ID)
+	d.Set("service_id", *service.ID)
+	d.Set("display_name", globalCatalogDisplayName(*service))
+	if err = d.Set("plans", planList); err != nil {
+		return fmt.Errorf("[ERROR] Error setting plans: %s", err)
+	}
+	return nil
+}
+
+// globalCatalogChildren lists all child entries of kind of a catalog entry.
+func globalCatalogChildren(globalCatalogClient *globalcatalogv1.GlobalCatalogV1, id, kind string) ([]globalcatalogv1.CatalogEntry, error) {
+	children := []globalcatalogv1.CatalogEntry{}
+	var offset int64
+	for {
+		getChildObjectsOptions := &globalcatalogv1.GetChildObjectsOptions{
+			ID:     &id,
+			Kind:   &kind,
+			Offset: core.Int64Ptr(offset),
+			Limit:  core.Int64Ptr(globalCatalogPageLimit),
+		}
+		result, response, err := globalCatalogClient.GetChildObjects(getChildObjectsOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing %s entries of global catalog entry %s: %s\n%s", kind, id, err, response)
+		}
+		children = append(children, result.Resources...)
+		offset += int64(len(result.Resources))
+		if len(result.Resources) == 0 || result.Count == nil || offset >= *result.Count {
+			break
+		}
+	}
+	return children, nil
+}
+
+func globalCatalogDisplayName(entry globalcatalogv1.CatalogEntry) string {
+	if overview, ok := entry.OverviewUI["en"]; ok && overview.DisplayName != nil {
+		return *overview.DisplayName
+	}
+	if entry.Name != nil {
+		return *entry.Name
+	}
+	return ""
+}
+
+func globalCatalogContains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func flattenGlobalCatalogPricingMetrics(metrics []globalcatalogv1.Metrics, country string) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(metrics))
+	for _, metric := range metrics {
+		m := map[string]interface{}{}
+		if metric.MetricID != nil {
+			m["metric_id"] = *metric.MetricID
+		}
+		if metric.ChargeUnitName != nil {
+			m["charge_unit_name"] = *metric.ChargeUnitName
+		}
+		if metric.ChargeUnitDisplayName != nil {
+			m["charge_unit_display_name"] = *metric.ChargeUnitDisplayName
+		}
+		for _, amount := range metric.Amounts {
+			if amount.Country == nil || *amount.Country != country {
+				continue
+			}
+			if amount.Currency != nil {
+				m["currency"] = *amount.Currency
+			}
+			prices := make([]map[string]interface{}, 0, len(amount.Prices))
+			for _, price := range amount.Prices {
+				p := map[string]interface{}{}
+				if price.QuantityTier != nil {
+					p["quantity_tier"] = int(*price.QuantityTier)
+				}
+				if price.Price != nil {
+					p["price"] = *price.Price
+				}
+				prices = append(prices, p)
+			}
+			m["prices"] = prices
+		}
+		result = append(result, m)
+	}
+	return result
+}
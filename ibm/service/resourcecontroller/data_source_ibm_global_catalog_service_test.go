@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMGlobalCatalogServiceDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMGlobalCatalogServiceDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_global_catalog_service.kms", "service_id"),
+					resource.TestCheckResourceAttr("data.ibm_global_catalog_service.kms", "plans.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_global_catalog_service.kms", "plans.0.name", "tiered-pricing"),
+					resource.TestCheckResourceAttr("data.ibm_global_catalog_service.kms", "plans.0.locations.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_global_catalog_service.kms", "plans.0.locations.0", "us-south"),
+					resource.TestCheckResourceAttrSet("data.ibm_global_catalog_service.kms", "plans.0.pricing.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMGlobalCatalogServiceDataSourceConfig() string {
+	return `
+	data "ibm_global_catalog_service" "kms" {
+		name            = "kms"
+		plan            = "tiered-pricing"
+		location        = "us-south"
+		include_pricing = true
+	}`
+}
@@ -23,9 +23,11 @@ func DataSourceIBMResourceKey() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Description: "The name of the resource key",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:  "The name of the resource key",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"name", "resource_instance_id", "resource_alias_id"},
 			},
 
 			"resource_instance_id": {
@@ -44,8 +46,9 @@ func DataSourceIBMResourceKey() *schema.Resource {
 
 			"role": {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
-				Description: "User role",
+				Description: "User role. If set, only resource keys with this role are considered",
 			},
 
 			"status": {
@@ -76,6 +79,13 @@ func DataSourceIBMResourceKey() *schema.Resource {
 				Default:  false,
 			},
 
+			"redact_credentials": {
+				Description: "If true, the credentials of the resource key are not stored in the state",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
 			"crn": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -100,7 +110,7 @@ func dataSourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) erro
 	}
 	var filteredKeys []models.ServiceKey
 
-	if d.Get("resource_instance_id") == "" {
+	if d.Get("resource_instance_id") == "" && d.Get("resource_alias_id") == "" {
 		filteredKeys = keys
 	} else {
 		crn, err := getCRN(d, meta)
@@ -115,8 +125,18 @@ func dataSourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) erro
 
 	}
 
+	if role, ok := d.GetOk("role"); ok {
+		var roleKeys []models.ServiceKey
+		for _, key := range filteredKeys {
+			if strings.EqualFold(resourceKeyRoleName(key), role.(string)) {
+				roleKeys = append(roleKeys, key)
+			}
+		}
+		filteredKeys = roleKeys
+	}
+
 	if len(filteredKeys) == 0 {
-		return fmt.Errorf("[ERROR] No resource keys found with name [%s]", name)
+		return fmt.Errorf("[ERROR] No resource keys found with %s", resourceKeyFilters(d))
 	}
 
 	var key models.ServiceKey
@@ -125,9 +145,9 @@ func dataSourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) erro
 		if mostRecent {
 			key = mostRecentResourceKey(filteredKeys)
 		} else {
-			return fmt.Errorf("[ERROR] More than one resource key found with name matching [%s]. "+
+			return fmt.Errorf("[ERROR] More than one resource key found with %s. "+
 				"Set 'most_recent' to true in your configuration to force the most recent resource key "+
-				"to be used", name)
+				"to be used", resourceKeyFilters(d))
 		}
 	} else {
 		key = filteredKeys[0]
@@ -135,25 +155,49 @@ func dataSourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(key.ID)
 
-	if roleCrn, ok := key.Parameters["role_crn"].(string); ok {
-		d.Set("role", roleCrn[strings.LastIndex(roleCrn, ":")+1:])
-	} else if roleCrn, ok := key.Credentials["iam_role_crn"].(string); ok {
-		d.Set("role", roleCrn[strings.LastIndex(roleCrn, ":")+1:])
+	if role := resourceKeyRoleName(key); role != "" {
+		d.Set("role", role)
 	}
 
-	d.Set("credentials", flex.Flatten(key.Credentials))
-	creds, err := json.Marshal(key.Credentials)
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error marshalling resource key credentials: %s", err)
-	}
-	if err = d.Set("credentials_json", string(creds)); err != nil {
-		return fmt.Errorf("[ERROR] Error setting the credentials json: %s", err)
+	if d.Get("redact_credentials").(bool) {
+		d.Set("credentials", map[string]interface{}{})
+		d.Set("credentials_json", "")
+	} else {
+		d.Set("credentials", flex.Flatten(key.Credentials))
+		creds, err := json.Marshal(key.Credentials)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error marshalling resource key credentials: %s", err)
+		}
+		if err = d.Set("credentials_json", string(creds)); err != nil {
+			return fmt.Errorf("[ERROR] Error setting the credentials json: %s", err)
+		}
 	}
+	d.Set("name", key.Name)
 	d.Set("status", key.State)
 	d.Set("crn", key.Crn.String())
 	return nil
 }
 
+// resourceKeyFilters describes the arguments that select the resource key.
+func resourceKeyFilters(d *schema.ResourceData) string {
+	filters := []string{}
+	for _, arg := range []string{"name", "resource_instance_id", "resource_alias_id", "role"} {
+		if v, ok := d.GetOk(arg); ok {
+			filters = append(filters, fmt.Sprintf("%s [%s]", arg, v.(string)))
+		}
+	}
+	return strings.Join(filters, " and ")
+}
+
+func resourceKeyRoleName(key models.ServiceKey) string {
+	if roleCrn, ok := key.Parameters["role_crn"].(string); ok {
+		return roleCrn[strings.LastIndex(roleCrn, ":")+1:]
+	} else if roleCrn, ok := key.Credentials["iam_role_crn"].(string); ok {
+		return roleCrn[strings.LastIndex(roleCrn, ":")+1:]
+	}
+	return ""
+}
+
 func getCRN(d *schema.ResourceData, meta interface{}) (*crn.CRN, error) {
 
 	rsContClient, err := meta.(conns.ClientSession).ResourceControllerAPI()
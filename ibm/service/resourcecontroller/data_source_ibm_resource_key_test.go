@@ -57,6 +57,27 @@ func TestAccIBMResourceKeyDataSource_mostrecent(t *testing.T) {
 	})
 }
 
+func TestAccIBMResourceKeyDataSource_roleRedacted(t *testing.T) {
+	resourceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	resourceKey := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceKeyDataSourceConfigRoleRedacted(resourceName, resourceKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_resource_key.testacc_ds_resource_key", "name", resourceKey+"-writer"),
+					resource.TestCheckResourceAttr("data.ibm_resource_key.testacc_ds_resource_key", "role", "Writer"),
+					resource.TestCheckResourceAttr("data.ibm_resource_key.testacc_ds_resource_key", "credentials.%", "0"),
+					resource.TestCheckResourceAttr("data.ibm_resource_key.testacc_ds_resource_key", "credentials_json", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMResourceKeyDataSourceConfig(resourceName, resourceKey string) string {
 	return fmt.Sprintf(`
 
@@ -120,3 +141,35 @@ data "ibm_resource_key" "testacc_ds_resource_key1" {
 `, resourceName, resourceKey, resourceKey)
 
 }
+
+func testAccCheckIBMResourceKeyDataSourceConfigRoleRedacted(resourceName, resourceKey string) string {
+	return fmt.Sprintf(`
+
+resource "ibm_resource_instance" "resource" {
+  name     = "%s"
+  service  = "cloud-object-storage"
+  plan     = "standard"
+  location = "global"
+}
+
+resource "ibm_resource_key" "resourcekey" {
+  name                 = "%s-reader"
+  role                 = "Reader"
+  resource_instance_id = ibm_resource_instance.resource.id
+}
+
+resource "ibm_resource_key" "resourcekey1" {
+  name                 = "%s-writer"
+  role                 = "Writer"
+  resource_instance_id = ibm_resource_instance.resource.id
+}
+
+data "ibm_resource_key" "testacc_ds_resource_key" {
+  resource_instance_id = ibm_resource_instance.resource.id
+  role                 = "Writer"
+  redact_credentials   = true
+  depends_on           = [ibm_resource_key.resourcekey, ibm_resource_key.resourcekey1]
+}
+`, resourceName, resourceKey, resourceKey)
+
+}
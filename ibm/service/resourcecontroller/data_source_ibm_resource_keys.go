@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func DataSourceIBMResourceKeys() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMResourceKeysRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The id of the resource instance whose keys are listed",
+			},
+
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The resource keys of the resource instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The id of the resource key",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the resource key",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "crn of resource key",
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "User role",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the key",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date when the key was created",
+						},
+						"credentials": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Sensitive:   true,
+							Description: "Credentials asociated with the key",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMResourceKeysRead(d *schema.ResourceData, meta interface{}) error {
+	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+	instanceID := d.Get("resource_instance_id").(string)
+
+	start := ""
+	allKeys := []rc.ResourceKey{}
+	for {
+		listResourceKeysOptions := &rc.ListResourceKeysForInstanceOptions{
+			ID: &instanceID,
+		}
+		if start != "" {
+			listResourceKeysOptions.Start = &start
+		}
+		keyList, resp, err := rsContClient.ListResourceKeysForInstance(listResourceKeysOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing resource keys of resource instance %s: %s with resp : %s", instanceID, err, resp)
+		}
+		allKeys = append(allKeys, keyList.Resources...)
+		start, err = getResourceKeysNext(keyList.NextURL)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error parsing next url of resource keys list: %s", err)
+		}
+		if start == "" {
+			break
+		}
+	}
+
+	keys := make([]map[string]interface{}, 0, len(allKeys))
+	for _, key := range allKeys {
+		if key.State != nil && *key.State == "removed" {
+			continue
+		}
+		k := map[string]interface{}{}
+		if key.ID != nil {
+			k["id"] = *key.ID
+		}
+		if key.Name != nil {
+			k["name"] = *key.Name
+		}
+		if key.CRN != nil {
+			k["crn"] = *key.CRN
+		}
+		if key.State != nil {
+			k["state"] = *key.State
+		}
+		if key.CreatedAt != nil {
+			k["created_at"] = key.CreatedAt.String()
+		}
+		if key.Credentials != nil {
+			if key.Credentials.IamRoleCRN != nil {
+				roleCrn := *key.Credentials.IamRoleCRN
+				k["role"] = roleCrn[strings.LastIndex(roleCrn, ":")+1:]
+			}
+			var credInterface map[string]interface{}
+			cred, _ := json.Marshal(key.Credentials)
+			json.Unmarshal(cred, &credInterface)
+			k["credentials"] = flex.Flatten(credInterface)
+		}
+		keys = append(keys, k)
+	}
+
+	d.SetId(instanceID)
+	if err = d.Set("keys", keys); err != nil {
+		return fmt.Errorf("[ERROR] Error setting resource keys: %s", err)
+	}
+	return nil
+}
+
+func getResourceKeysNext(next *string) (string, error) {
+	if next == nil || *next == "" {
+		return "", nil
+	}
+	u, err := url.Parse(*next)
+	if err != nil {
+		return "", err
+	}
+	return u.Query().Get("start"), nil
+}
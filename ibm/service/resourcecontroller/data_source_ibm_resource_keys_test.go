@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMResourceKeysDataSource_basic(t *testing.T) {
+	resourceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	resourceKey := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceKeysDataSourceConfig(resourceName, resourceKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_resource_keys.testacc_ds_resource_keys", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_resource_keys.testacc_ds_resource_keys", "keys.0.name", resourceKey),
+					resource.TestCheckResourceAttr("data.ibm_resource_keys.testacc_ds_resource_keys", "keys.0.role", "Manager"),
+					resource.TestCheckResourceAttrSet("data.ibm_resource_keys.testacc_ds_resource_keys", "keys.0.credentials.Sysdig Access Key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMResourceKeysDataSourceConfig(resourceName, resourceKey string) string {
+	return fmt.Sprintf(`
+
+resource "ibm_resource_instance" "resource" {
+  name     = "%s"
+  service  = "sysdig-monitor"
+  plan     = "graduated-tier"
+  location = "us-south"
+}
+
+resource "ibm_resource_key" "resourcekey" {
+  name                 = "%s"
+  role                 = "Manager"
+  resource_instance_id = ibm_resource_instance.resource.id
+  rotation_trigger     = "1"
+}
+
+data "ibm_resource_keys" "testacc_ds_resource_keys" {
+  resource_instance_id = ibm_resource_key.resourcekey.resource_instance_id
+}
+`, resourceName, resourceKey)
+}
@@ -72,6 +72,13 @@ func ResourceIBMResourceKey() *schema.Resource {
 				Description:      "Arbitrary parameters to pass. Must be a JSON object",
 			},
 
+			"rotation_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "An arbitrary value. Changing it replaces the resource key, which rotates its credentials",
+			},
+
 			"credentials": {
 				Description: "Credentials asociated with the key",
 				Type:        schema.TypeMap,
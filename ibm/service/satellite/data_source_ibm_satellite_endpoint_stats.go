@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMSatelliteEndpointStats() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmSatelliteEndpointStatsRead,
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Location ID.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"enabled", "disabled"}),
+				Description:  "Whether to only include enabled or disabled endpoints. If not specified all endpoints are included.",
+			},
+			"total_connections": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Concurrent connections number of all the endpoints.",
+			},
+			"total_rx_bandwidth": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Average Receive (to Cloud) Bandwidth of all the endpoints in the last two minutes, unit is Byte/s.",
+			},
+			"total_tx_bandwidth": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Average Transmitted (to Location) Bandwidth of all the endpoints in the last two minutes, unit is Byte/s.",
+			},
+			"total_bandwidth": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Average Total Bandwidth of all the endpoints in the last two minutes, unit is Byte/s.",
+			},
+			"endpoints": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The last performance data of each endpoint.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Endpoint ID.",
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The display name of the endpoint.",
+						},
+						"connection_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the endpoint.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Whether the Endpoint is active or not.",
+						},
+						"connection": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Concurrent connections number of moment when probe read the data.",
+						},
+						"rx_bandwidth": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Average Receive (to Cloud) Bandwidth of last two minutes, unit is Byte/s.",
+						},
+						"tx_bandwidth": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Average Transmitted (to Location) Bandwidth of last two minutes, unit is Byte/s.",
+						},
+						"bandwidth": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Average Total Bandwidth of last two minutes, unit is Byte/s.",
+						},
+						"connectors": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The last performance data of the endpoint from each Connector.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"connector": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the connector reported the performance data.",
+									},
+									"connections": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Concurrent connections number of moment when probe read the data from the Connector.",
+									},
+									"rx_bw": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Average Receive (to Cloud) Bandwidth of last two minutes read from the Connector, unit is Byte/s.",
+									},
+									"tx_bw": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Average Transmitted (to Location) Bandwidth of last two minutes read from the Connector, unit is Byte/s.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIbmSatelliteEndpointStatsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	satelliteLinkClient, err := meta.(conns.ClientSession).SatellitLinkClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	location := d.Get("location").(string)
+	listEndpointsOptions := &satellitelinkv1.ListEndpointsOptions{}
+	listEndpointsOptions.SetLocationID(location)
+	if endpointType, ok := d.GetOk("type"); ok {
+		listEndpointsOptions.SetType(endpointType.(string))
+	}
+
+	endpoints, response, err := satelliteLinkClient.ListEndpointsWithContext(context, listEndpointsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListEndpointsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ListEndpointsWithContext failed %s\n%s", err, response))
+	}
+
+	var connections, rxBandwidth, txBandwidth, bandwidth int
+	endpointList := []map[string]interface{}{}
+	for _, endpoint := range endpoints.Endpoints {
+		endpointMap := map[string]interface{}{
+			"endpoint_id":     core.StringNilMapper(endpoint.EndpointID),
+			"display_name":    core.StringNilMapper(endpoint.DisplayName),
+			"connection_type": core.StringNilMapper(endpoint.ConnType),
+			"status":          core.StringNilMapper(endpoint.Status),
+		}
+		if performance := endpoint.Performance; performance != nil {
+			performanceMap := dataSourceEndpointPerformanceToMap(*performance)
+			for k, v := range performanceMap {
+				endpointMap[k] = v
+			}
+			connections += flex.IntValue(performance.Connection)
+			rxBandwidth += flex.IntValue(performance.RxBandwidth)
+			txBandwidth += flex.IntValue(performance.TxBandwidth)
+			bandwidth += flex.IntValue(performance.Bandwidth)
+		}
+		endpointList = append(endpointList, endpointMap)
+	}
+
+	d.SetId(location)
+	d.Set("total_connections", connections)
+	d.Set("total_rx_bandwidth", rxBandwidth)
+	d.Set("total_tx_bandwidth", txBandwidth)
+	d.Set("total_bandwidth", bandwidth)
+	if err = d.Set("endpoints", endpointList); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting endpoints %s", err))
+	}
+
+	return nil
+}
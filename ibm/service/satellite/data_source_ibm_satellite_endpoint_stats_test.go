@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIbmSatelliteEndpointStatsDataSourceBasic(t *testing.T) {
+	endpointLocationID := fmt.Sprintf("tf-satellite-loc-%d", acctest.RandIntRange(10, 100))
+	displayName := fmt.Sprintf("tf-display-name-%d", acctest.RandIntRange(10, 100))
+	serverPort := fmt.Sprintf("%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmSatelliteEndpointStatsDataSourceConfigBasic(endpointLocationID, displayName, serverPort),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_satellite_endpoint_stats.stats", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_satellite_endpoint_stats.stats", "endpoints.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_satellite_endpoint_stats.stats", "total_connections"),
+					resource.TestCheckResourceAttrSet("data.ibm_satellite_endpoint_stats.stats", "total_bandwidth"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSatelliteEndpointStatsDataSourceConfigBasic(locationID string, displayName string, serverPort string) string {
+	return fmt.Sprintf(`
+		resource "ibm_satellite_endpoint" "satellite_endpoint" {
+			location  = "%s"
+			connection_type = "location"
+			display_name = "%s"
+			server_host = "cloud.ibm.com"
+			server_port = %s
+			server_protocol = "tls"
+			client_protocol = "https"
+		}
+
+		data "ibm_satellite_endpoint_stats" "stats" {
+			location = ibm_satellite_endpoint.satellite_endpoint.location
+		}
+	`, locationID, displayName, serverPort)
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"strings"
 	"time"
 
@@ -70,7 +69,7 @@ func ResourceIBMSatelliteCluster() *schema.Resource {
 				return flex.ImmutableResourceCustomizeDiff([]string{"name", "location", "resource_group_id", "crn_token"}, diff)
 			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -387,7 +386,7 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 		log.Printf("[INFO] Created ROKS Satellite Cluster : %s", clusterId)
 	}
 
-	d.SetId(clusterId)
+	flex.TrackCreatedID(d, clusterId)
 
 	//Create zone in default workerpool
 	workerPoolName := "default"
@@ -441,8 +440,8 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	v := flex.EnvTags(meta)
+	if _, ok := d.GetOk("tags"); ok || len(v) > 0 {
 		getSatClusterOptions := &kubernetesserviceapiv1.GetClusterOptions{
 			Cluster: flex.PtrToString(clusterId),
 		}
@@ -464,7 +463,7 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 	//Wait for cluster to get warning state
 	_, err = waitForClusterToReady(clusterId, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for getting cluster (%s) to be warning state: %s", clusterId, err)
+		return flex.TrackedCreateError(d, "satellite cluster", err)
 	}
 
 	return resourceIBMSatelliteClusterRead(d, meta)
@@ -666,8 +665,8 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
+	v := flex.EnvTags(meta)
+	if d.HasChange("tags") || len(v) > 0 {
 		oldList, newList := d.GetChange("tags")
 		getSatClusterOptions := &kubernetesserviceapiv1.GetClusterOptions{
 			Cluster:            &clusterID,
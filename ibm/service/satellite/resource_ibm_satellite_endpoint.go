@@ -888,9 +888,21 @@ func resourceIbmSatelliteEndpointUpdate(context context.Context, d *schema.Resou
 		hasChange = true
 	}
 	if d.HasChange("certs") {
-		certs := resourceIbmSatelliteEndpointUpdateEndpointRequestCerts(d.Get("certs.0").(map[string]interface{}))
-		updateEndpointsOptions.SetCerts(&certs)
-		hasChange = true
+		if certsMap, ok := d.Get("certs.0").(map[string]interface{}); ok {
+			certs := resourceIbmSatelliteEndpointUpdateEndpointRequestCerts(certsMap)
+			updateEndpointsOptions.SetCerts(&certs)
+			hasChange = true
+		} else {
+			// The certs block was removed, delete the uploaded certs and key
+			deleteEndpointCertsOptions := &satellitelinkv1.DeleteEndpointCertsOptions{}
+			deleteEndpointCertsOptions.SetLocationID(parts[0])
+			deleteEndpointCertsOptions.SetEndpointID(parts[1])
+			_, response, err := satelliteLinkClient.DeleteEndpointCertsWithContext(context, deleteEndpointCertsOptions)
+			if err != nil {
+				log.Printf("[DEBUG] DeleteEndpointCertsWithContext failed %s\n%s", err, response)
+				return diag.FromErr(fmt.Errorf("DeleteEndpointCertsWithContext failed %s\n%s", err, response))
+			}
+		}
 	}
 
 	if hasChange {
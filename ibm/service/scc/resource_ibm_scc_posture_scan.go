@@ -0,0 +1,312 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package scc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM/scc-go-sdk/v3/posturemanagementv2"
+)
+
+const (
+	sccPostureScanPending   = "pending"
+	sccPostureScanCompleted = "completed"
+)
+
+func ResourceIBMSccPostureScan() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSccPostureScanCreate,
+		ReadContext:   resourceIBMSccPostureScanRead,
+		UpdateContext: resourceIBMSccPostureScanUpdate,
+		DeleteContext: resourceIBMSccPostureScanDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"scope_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique ID of the scope to scan.",
+			},
+			"profile_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique ID of the profile to validate the scope against.",
+			},
+			"group_profile_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The ID of the profile group.",
+			},
+			"minimum_pass_threshold": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatBetween(0, 100),
+				Description:  "The minimum percentage of evaluated controls that must pass. The apply fails when the pass_percentage of the scan is lower.",
+			},
+			"scan_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A system-generated name that is the combination of 12 characters in the scope name and 12 characters of a profile name.",
+			},
+			"start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time the scan was run.",
+			},
+			"end_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time when the scan completed.",
+			},
+			"controls_pass_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of controls that passed the scan.",
+			},
+			"controls_fail_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of controls that failed the scan.",
+			},
+			"controls_not_applicable_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of controls that are not relevant to the current scan.",
+			},
+			"controls_unable_to_perform_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of controls that can't be validated.",
+			},
+			"controls_total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of controls that are included in the scan.",
+			},
+			"goals_pass_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of goals that passed the scan.",
+			},
+			"goals_fail_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of goals that failed the scan.",
+			},
+			"goals_total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of goals that were included in the scan.",
+			},
+			"pass_percentage": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The percentage of passed controls among the controls that passed or failed.",
+			},
+		},
+	}
+}
+
+func resourceIBMSccPostureScanCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	postureManagementClient, err := meta.(conns.ClientSession).PostureManagementV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting userDetails %s", err))
+	}
+	accountID := userDetails.UserAccount
+	scopeID := d.Get("scope_id").(string)
+	profileID := d.Get("profile_id").(string)
+
+	createValidationOptions := &posturemanagementv2.CreateValidationOptions{}
+	createValidationOptions.SetAccountID(accountID)
+	createValidationOptions.SetScopeID(scopeID)
+	createValidationOptions.SetProfileID(profileID)
+	if v, ok := d.GetOk("group_profile_id"); ok {
+		createValidationOptions.SetGroupProfileID(v.(string))
+	}
+
+	// The validation does not return its scan, the scan is the next scan of the
+	// scope. The latest scan before the validation is excluded, since the
+	// lookback for clock skew could match it.
+	previous, err := sccPostureScanFind(context, postureManagementClient, accountID, scopeID, profileID, time.Time{}, "")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	previousScanID := ""
+	if previous != nil {
+		previousScanID = *previous.ScanID
+	}
+	triggeredAt := time.Now().Add(-1 * time.Minute)
+	result, response, err := postureManagementClient.CreateValidationWithContext(context, createValidationOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateValidationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateValidationWithContext failed %s\n%s", err, response))
+	}
+	if result.Result != nil && !*result.Result {
+		return diag.FromErr(fmt.Errorf("[ERROR] Scan of scope %s was not started: %s", scopeID, core.StringNilMapper(result.Message)))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{sccPostureScanPending},
+		Target:  []string{sccPostureScanCompleted},
+		Refresh: func() (interface{}, string, error) {
+			scan, err := sccPostureScanFind(context, postureManagementClient, accountID, scopeID, profileID, triggeredAt, previousScanID)
+			if err != nil {
+				return nil, "", err
+			}
+			if scan == nil || scan.EndTime == nil || scan.Result == nil || time.Time(*scan.EndTime).Before(time.Time(*scan.StartTime)) {
+				return scan, sccPostureScanPending, nil
+			}
+			return scan, sccPostureScanCompleted, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      30 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+	scan, err := stateConf.WaitForStateContext(context)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for scan of scope %s to complete: %s", scopeID, err))
+	}
+
+	scanItem := scan.(*posturemanagementv2.ScanItem)
+	d.SetId(*scanItem.ScanID)
+	if err = resourceIBMSccPostureScanSetResult(d, scanItem); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSccPostureScanCheckThreshold(d)
+}
+
+// sccPostureScanFind returns the latest scan of the scope and profile that started after the given time,
+// other than the excluded scan.
+func sccPostureScanFind(context context.Context, postureManagementClient *posturemanagementv2.PostureManagementV2, accountID, scopeID, profileID string, startedAfter time.Time, excludeScanID string) (*posturemanagementv2.ScanItem, error) {
+	listLatestScansOptions := &posturemanagementv2.ListLatestScansOptions{}
+	listLatestScansOptions.SetAccountID(accountID)
+	listLatestScansOptions.Limit = core.Int64Ptr(int64(100))
+
+	var offset int64
+	for {
+		listLatestScansOptions.Offset = &offset
+		result, response, err := postureManagementClient.ListLatestScansWithContext(context, listLatestScansOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListLatestScansWithContext failed %s\n%s", err, response)
+			return nil, fmt.Errorf("ListLatestScansWithContext failed %s\n%s", err, response)
+		}
+		for i, scan := range result.LatestScans {
+			if scan.ScanID == nil || *scan.ScanID == excludeScanID || scan.ScopeID == nil || *scan.ScopeID != scopeID || scan.StartTime == nil || time.Time(*scan.StartTime).Before(startedAfter) {
+				continue
+			}
+			for _, profile := range scan.Profiles {
+				if profile.ID != nil && *profile.ID == profileID {
+					return &result.LatestScans[i], nil
+				}
+			}
+		}
+		offset = dataSourceScanListGetNext(result.Next)
+		if offset == 0 {
+			return nil, nil
+		}
+	}
+}
+
+func resourceIBMSccPostureScanSetResult(d *schema.ResourceData, scan *posturemanagementv2.ScanItem) error {
+	if err := d.Set("scan_name", scan.ScanName); err != nil {
+		return fmt.Errorf("[ERROR] Error setting scan_name: %s", err)
+	}
+	if scan.StartTime != nil {
+		if err := d.Set("start_time", scan.StartTime.String()); err != nil {
+			return fmt.Errorf("[ERROR] Error setting start_time: %s", err)
+		}
+	}
+	if scan.EndTime != nil {
+		if err := d.Set("end_time", scan.EndTime.String()); err != nil {
+			return fmt.Errorf("[ERROR] Error setting end_time: %s", err)
+		}
+	}
+	if scan.Result == nil {
+		return nil
+	}
+	counts := map[string]*int64{
+		"controls_pass_count":              scan.Result.ControlsPassCount,
+		"controls_fail_count":              scan.Result.ControlsFailCount,
+		"controls_not_applicable_count":    scan.Result.ControlsNotApplicableCount,
+		"controls_unable_to_perform_count": scan.Result.ControlsUnableToPerformCount,
+		"controls_total_count":             scan.Result.ControlsTotalCount,
+		"goals_pass_count":                 scan.Result.GoalsPassCount,
+		"goals_fail_count":                 scan.Result.GoalsFailCount,
+		"goals_total_count":                scan.Result.GoalsTotalCount,
+	}
+	for key, count := range counts {
+		if count == nil {
+			continue
+		}
+		if err := d.Set(key, int(*count)); err != nil {
+			return fmt.Errorf("[ERROR] Error setting %s: %s", key, err)
+		}
+	}
+	passPercentage := float64(100)
+	if scan.Result.ControlsPassCount != nil && scan.Result.ControlsFailCount != nil {
+		if evaluated := *scan.Result.ControlsPassCount + *scan.Result.ControlsFailCount; evaluated > 0 {
+			passPercentage = float64(*scan.Result.ControlsPassCount) * 100 / float64(evaluated)
+		}
+	}
+	if err := d.Set("pass_percentage", passPercentage); err != nil {
+		return fmt.Errorf("[ERROR] Error setting pass_percentage: %s", err)
+	}
+	return nil
+}
+
+func resourceIBMSccPostureScanCheckThreshold(d *schema.ResourceData) diag.Diagnostics {
+	threshold, ok := d.GetOk("minimum_pass_threshold")
+	if !ok {
+		return nil
+	}
+	if passPercentage := d.Get("pass_percentage").(float64); passPercentage < threshold.(float64) {
+		return diag.FromErr(fmt.Errorf("[ERROR] Scan %s passed %.2f%% of the evaluated controls (%d failed), which is below the minimum_pass_threshold of %.2f%%",
+			d.Id(), passPercentage, d.Get("controls_fail_count").(int), threshold.(float64)))
+	}
+	return nil
+}
+
+func resourceIBMSccPostureScanRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// A scan result does not change once the scan completed, so the recorded result is kept.
+	return nil
+}
+
+func resourceIBMSccPostureScanUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("minimum_pass_threshold") {
+		if diags := resourceIBMSccPostureScanCheckThreshold(d); diags.HasError() {
+			// Keep the previous threshold so that the next plan evaluates the gate again.
+			d.Partial(true)
+			return diags
+		}
+	}
+	return nil
+}
+
+func resourceIBMSccPostureScanDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Scans cannot be deleted, removing the resource only removes it from the state.
+	d.SetId("")
+	return nil
+}
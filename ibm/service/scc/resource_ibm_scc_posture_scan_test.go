@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package scc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSccPostureScanBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSccPostureScanConfigBasic(acc.Scc_posture_scope_id, acc.Scc_posture_profile_id),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_scc_posture_scan.scan", "id"),
+					resource.TestCheckResourceAttr("ibm_scc_posture_scan.scan", "scope_id", acc.Scc_posture_scope_id),
+					resource.TestCheckResourceAttrSet("ibm_scc_posture_scan.scan", "end_time"),
+					resource.TestCheckResourceAttrSet("ibm_scc_posture_scan.scan", "controls_total_count"),
+					resource.TestCheckResourceAttrSet("ibm_scc_posture_scan.scan", "pass_percentage"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSccPostureScanConfigBasic(scopeID string, profileID string) string {
+	return fmt.Sprintf(`
+		resource "ibm_scc_posture_scan" "scan" {
+			scope_id               = "%s"
+			profile_id             = "%s"
+			minimum_pass_threshold = 0
+		}
+	`, scopeID, profileID)
+}
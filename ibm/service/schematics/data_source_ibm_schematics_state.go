@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -31,8 +32,9 @@ func DataSourceIBMSchematicsState() *schema.Resource {
 			},
 			"template_id": {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The ID of the Terraform template for which you want to retrieve the Terraform statefile.  When you create a workspace, the Terraform template that your workspace points to is assigned a unique ID.  To find this ID, use the GET /v1/workspaces API and review the template_data.id value.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the Terraform template for which you want to retrieve the Terraform statefile.  When you create a workspace, the Terraform template that your workspace points to is assigned a unique ID.  To find this ID, use the GET /v1/workspaces API and review the template_data.id value. Defaults to the first template of the workspace.",
 			},
 			"state_store": {
 				Type:     schema.TypeString,
@@ -42,6 +44,102 @@ func DataSourceIBMSchematicsState() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"terraform_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Terraform version that wrote the state.",
+			},
+			"serial": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The serial number of the state.",
+			},
+			"lineage": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lineage of the state.",
+			},
+			"outputs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The root module outputs of the state.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the output.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The value of the output. String values are returned as is, other values are JSON encoded. Empty for sensitive outputs, use output_values instead.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The JSON encoded Terraform type of the output.",
+						},
+						"sensitive": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the output is marked as sensitive.",
+						},
+					},
+				},
+			},
+			"output_values": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The root module output values keyed by output name. String values are returned as is, other values are JSON encoded.",
+			},
+			"resources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The resources that are managed in the state.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The address of the resource, such as module.vpc.ibm_is_vpc.vpc.",
+						},
+						"mode": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The mode of the resource, managed or data.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the resource.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the resource.",
+						},
+						"module": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The module address of the resource. Empty for the root module.",
+						},
+						"provider": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The provider configuration of the resource.",
+						},
+						"instance_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The IDs of the resource instances.",
+						},
+					},
+				},
+			},
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -57,10 +155,25 @@ func dataSourceIBMSchematicsStateRead(context context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
+	templateID := d.Get("template_id").(string)
+	if templateID == "" {
+		getWorkspaceOptions := &schematicsv1.GetWorkspaceOptions{}
+		getWorkspaceOptions.SetWID(d.Get("workspace_id").(string))
+		workspaceResponse, response, err := schematicsClient.GetWorkspaceWithContext(context, getWorkspaceOptions)
+		if err != nil {
+			log.Printf("[DEBUG] GetWorkspaceWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("GetWorkspaceWithContext failed %s\n%s", err, response))
+		}
+		if len(workspaceResponse.TemplateData) == 0 || workspaceResponse.TemplateData[0].ID == nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Workspace %s has no template", *getWorkspaceOptions.WID))
+		}
+		templateID = *workspaceResponse.TemplateData[0].ID
+	}
+
 	getWorkspaceTemplateStateOptions := &schematicsv1.GetWorkspaceTemplateStateOptions{}
 
 	getWorkspaceTemplateStateOptions.SetWID(d.Get("workspace_id").(string))
-	getWorkspaceTemplateStateOptions.SetTID(d.Get("template_id").(string))
+	getWorkspaceTemplateStateOptions.SetTID(templateID)
 
 	_, response, _ := schematicsClient.GetWorkspaceTemplateStateWithContext(context, getWorkspaceTemplateStateOptions)
 	if response.StatusCode != 200 {
@@ -69,6 +182,7 @@ func dataSourceIBMSchematicsStateRead(context context.Context, d *schema.Resourc
 	}
 
 	d.SetId(dataSourceIBMSchematicsStateID(d))
+	d.Set("template_id", templateID)
 
 	var stateStore map[string]interface{}
 	json.Unmarshal(response.RawResult, &stateStore)
@@ -90,6 +204,28 @@ func dataSourceIBMSchematicsStateRead(context context.Context, d *schema.Resourc
 	stateStoreJSON := string(stateByte[:])
 	d.Set("state_store_json", stateStoreJSON)
 
+	var state schematicsStateFile
+	if err = json.Unmarshal(response.RawResult, &state); err != nil {
+		// Not every template state follows the Terraform state format, the raw
+		// state is still available in state_store_json
+		log.Printf("[WARN] Error parsing the state of workspace %s, its outputs and resources are not set: %s", d.Get("workspace_id").(string), err)
+		state = schematicsStateFile{}
+	}
+	d.Set("terraform_version", state.TerraformVersion)
+	d.Set("serial", state.Serial)
+	d.Set("lineage", state.Lineage)
+
+	outputs, outputValues := dataSourceSchematicsStateFlattenOutputs(state.Outputs)
+	if err = d.Set("outputs", outputs); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting outputs %s", err))
+	}
+	if err = d.Set("output_values", outputValues); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting output_values %s", err))
+	}
+	if err = d.Set("resources", dataSourceSchematicsStateFlattenResources(state.Resources)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resources %s", err))
+	}
+
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return diag.FromErr(err)
@@ -99,6 +235,94 @@ func dataSourceIBMSchematicsStateRead(context context.Context, d *schema.Resourc
 	return nil
 }
 
+// schematicsStateFile is the subset of the Terraform state format (version 4)
+// that is exposed by the data source.
+type schematicsStateFile struct {
+	TerraformVersion string                           `json:"terraform_version"`
+	Serial           int64                            `json:"serial"`
+	Lineage          string                           `json:"lineage"`
+	Outputs          map[string]schematicsStateOutput `json:"outputs"`
+	Resources        []schematicsStateResource        `json:"resources"`
+}
+
+type schematicsStateOutput struct {
+	Value     json.RawMessage `json:"value"`
+	Type      json.RawMessage `json:"type"`
+	Sensitive bool            `json:"sensitive"`
+}
+
+type schematicsStateResource struct {
+	Module    string `json:"module"`
+	Mode      string `json:"mode"`
+	Type      string `json:"type"`
+	Name      string `json:"name"`
+	Provider  string `json:"provider"`
+	Instances []struct {
+		Attributes map[string]interface{} `json:"attributes"`
+	} `json:"instances"`
+}
+
+// dataSourceSchematicsStateFlattenOutputs returns the outputs sorted by name.
+// Values of sensitive outputs are only returned in the sensitive map.
+func dataSourceSchematicsStateFlattenOutputs(stateOutputs map[string]schematicsStateOutput) ([]map[string]interface{}, map[string]string) {
+	names := make([]string, 0, len(stateOutputs))
+	for name := range stateOutputs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	outputs := make([]map[string]interface{}, 0, len(names))
+	outputValues := make(map[string]string, len(names))
+	for _, name := range names {
+		output := stateOutputs[name]
+		value := string(output.Value)
+		var str string
+		if err := json.Unmarshal(output.Value, &str); err == nil {
+			value = str
+		}
+		o := map[string]interface{}{
+			"name":      name,
+			"type":      string(output.Type),
+			"sensitive": output.Sensitive,
+		}
+		if !output.Sensitive {
+			o["value"] = value
+		}
+		outputs = append(outputs, o)
+		outputValues[name] = value
+	}
+	return outputs, outputValues
+}
+
+func dataSourceSchematicsStateFlattenResources(stateResources []schematicsStateResource) []map[string]interface{} {
+	resources := make([]map[string]interface{}, 0, len(stateResources))
+	for _, r := range stateResources {
+		address := fmt.Sprintf("%s.%s", r.Type, r.Name)
+		if r.Mode == "data" {
+			address = "data." + address
+		}
+		if r.Module != "" {
+			address = r.Module + "." + address
+		}
+		ids := make([]string, 0, len(r.Instances))
+		for _, instance := range r.Instances {
+			if id, ok := instance.Attributes["id"].(string); ok {
+				ids = append(ids, id)
+			}
+		}
+		resources = append(resources, map[string]interface{}{
+			"address":      address,
+			"mode":         r.Mode,
+			"type":         r.Type,
+			"name":         r.Name,
+			"module":       r.Module,
+			"provider":     r.Provider,
+			"instance_ids": ids,
+		})
+	}
+	return resources
+}
+
 // dataSourceIBMSchematicsStateID returns a reasonable ID for the list.
 func dataSourceIBMSchematicsStateID(d *schema.ResourceData) string {
 	return time.Now().UTC().String()
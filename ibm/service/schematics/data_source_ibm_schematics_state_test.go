@@ -23,6 +23,8 @@ func TestAccIBMSchematicsStateDataSourceBasic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.ibm_schematics_state.schematics_state", "id"),
 					resource.TestCheckResourceAttrSet("data.ibm_schematics_state.schematics_state", "state_store"),
+					resource.TestCheckResourceAttrSet("data.ibm_schematics_state.schematics_state", "terraform_version"),
+					resource.TestCheckResourceAttrSet("data.ibm_schematics_state.schematics_state", "resources.#"),
 				),
 			},
 		},
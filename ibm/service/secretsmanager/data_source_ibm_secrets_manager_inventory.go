@@ -0,0 +1,325 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const secretsManagerInventoryPageLimit = 200
+
+func DataSourceIBMSecretsManagerInventory() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMSecretsManagerInventoryRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Secrets Manager instance GUID",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_secrets_manager_inventory", "endpoint_type"),
+				Description:  "Endpoint Type. 'public' or 'private'",
+				Default:      "public",
+			},
+			"secret_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_secrets_manager_inventory", "secret_type"),
+				Description:  "Only list the secrets of this type.",
+			},
+			"secret_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the secrets of this secret group.",
+			},
+			"labels": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only list the secrets that have all these labels.",
+			},
+			"expires_within_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_secrets_manager_inventory", "expires_within_days"),
+				Description:  "Only list the secrets that expire within this number of days, including the expired secrets.",
+			},
+			"search": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the secrets that match this search text, in their name, labels or description.",
+			},
+			"total": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of listed secrets.",
+			},
+			"secrets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The metadata of the secrets, without their payloads.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"secret_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The v4 UUID that uniquely identifies the secret.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The human-readable alias of the secret.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The extended description of the secret.",
+						},
+						"secret_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The secret type.",
+						},
+						"secret_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The v4 UUID that uniquely identifies the secret group of the secret.",
+						},
+						"labels": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The labels of the secret.",
+						},
+						"state": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The secret state based on NIST SP 800-57.",
+						},
+						"state_description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A text representation of the secret state.",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Cloud Resource Name (CRN) that uniquely identifies the secret.",
+						},
+						"creation_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date the secret was created. The date format follows RFC 3339.",
+						},
+						"created_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for the entity that created the secret.",
+						},
+						"last_update_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Updates when the actual secret is modified. The date format follows RFC 3339.",
+						},
+						"expiration_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date the secret material expires. The date format follows RFC 3339.",
+						},
+						"next_rotation_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date that the secret is scheduled for automatic rotation.",
+						},
+						"versions_total": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of versions of the secret.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceIBMSecretsManagerInventoryValidator() *validate.ResourceValidator {
+
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "secret_type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "arbitrary, iam_credentials, imported_cert, public_cert, private_cert, username_password, kv"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "endpoint_type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "public, private"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "expires_within_days",
+			ValidateFunctionIdentifier: validate.IntAtLeast,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "0"})
+
+	ibmSecretsManagerInventoryValidator := validate.ResourceValidator{ResourceName: "ibm_secrets_manager_inventory", Schema: validateSchema}
+	return &ibmSecretsManagerInventoryValidator
+}
+
+func dataSourceIBMSecretsManagerInventoryRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bluemixSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	region := bluemixSession.Config.Region
+
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rContollerClient, err := meta.(conns.ClientSession).ResourceControllerAPIV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	instanceData, err := rContollerClient.ResourceServiceInstanceV2().GetInstance(instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	crnData := strings.Split(instanceData.Crn.String(), ":")
+	if crnData[4] != "secrets-manager" {
+		return diag.FromErr(fmt.Errorf("[ERROR] Invalid or unsupported service Instance"))
+	}
+	smEndpointURL := "https://" + instanceID + "." + region + ".secrets-manager.appdomain.cloud"
+	if d.Get("endpoint_type").(string) == "private" {
+		smEndpointURL = "https://" + instanceID + ".private." + region + ".secrets-manager.appdomain.cloud"
+	}
+	secretsManagerClient.Service.Options.URL = conns.EnvFallBack([]string{"IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT"}, smEndpointURL)
+
+	secrets := []secretsmanagerv1.SecretResource{}
+	offset := int64(0)
+	for {
+		listAllSecretsOptions := &secretsmanagerv1.ListAllSecretsOptions{
+			Limit:  core.Int64Ptr(secretsManagerInventoryPageLimit),
+			Offset: core.Int64Ptr(offset),
+		}
+		if search, ok := d.GetOk("search"); ok {
+			listAllSecretsOptions.Search = core.StringPtr(search.(string))
+		}
+		listSecrets, response, err := secretsManagerClient.ListAllSecretsWithContext(context, listAllSecretsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListAllSecretsWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+		for _, data := range listSecrets.Resources {
+			if secret, ok := data.(*secretsmanagerv1.SecretResource); ok && secretsManagerInventoryMatch(d, *secret) {
+				secrets = append(secrets, *secret)
+			}
+		}
+		offset += int64(len(listSecrets.Resources))
+		if len(listSecrets.Resources) < secretsManagerInventoryPageLimit {
+			break
+		}
+	}
+
+	secretList := make([]map[string]interface{}, 0, len(secrets))
+	for _, secret := range secrets {
+		secretList = append(secretList, dataSourceSecretsManagerInventorySecretToMap(secret))
+	}
+	d.SetId(instanceID)
+	d.Set("total", len(secretList))
+	if err = d.Set("secrets", secretList); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting secrets %s", err))
+	}
+	return nil
+}
+
+// secretsManagerInventoryMatch reports whether the secret matches the
+// secret_type, secret_group_id, labels and expires_within_days filters.
+func secretsManagerInventoryMatch(d *schema.ResourceData, secret secretsmanagerv1.SecretResource) bool {
+	if secretType, ok := d.GetOk("secret_type"); ok && (secret.SecretType == nil || *secret.SecretType != secretType.(string)) {
+		return false
+	}
+	if groupID, ok := d.GetOk("secret_group_id"); ok && (secret.SecretGroupID == nil || *secret.SecretGroupID != groupID.(string)) {
+		return false
+	}
+	if labels, ok := d.GetOk("labels"); ok {
+		for _, label := range flex.ExpandStringList(labels.([]interface{})) {
+			found := false
+			for _, secretLabel := range secret.Labels {
+				if secretLabel == label {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+	if days, ok := d.GetOkExists("expires_within_days"); ok {
+		if secret.ExpirationDate == nil {
+			return false
+		}
+		deadline := time.Now().Add(time.Duration(days.(int)) * 24 * time.Hour)
+		if time.Time(*secret.ExpirationDate).After(deadline) {
+			return false
+		}
+	}
+	return true
+}
+
+func dataSourceSecretsManagerInventorySecretToMap(secret secretsmanagerv1.SecretResource) map[string]interface{} {
+	secretMap := map[string]interface{}{
+		"secret_id":         core.StringNilMapper(secret.ID),
+		"name":              core.StringNilMapper(secret.Name),
+		"description":       core.StringNilMapper(secret.Description),
+		"secret_type":       core.StringNilMapper(secret.SecretType),
+		"secret_group_id":   core.StringNilMapper(secret.SecretGroupID),
+		"labels":            secret.Labels,
+		"state_description": core.StringNilMapper(secret.StateDescription),
+		"crn":               core.StringNilMapper(secret.CRN),
+		"created_by":        core.StringNilMapper(secret.CreatedBy),
+		"versions_total":    len(secret.Versions),
+	}
+	if secret.State != nil {
+		secretMap["state"] = int(*secret.State)
+	}
+	if secret.CreationDate != nil {
+		secretMap["creation_date"] = secret.CreationDate.String()
+	}
+	if secret.LastUpdateDate != nil {
+		secretMap["last_update_date"] = secret.LastUpdateDate.String()
+	}
+	if secret.ExpirationDate != nil {
+		secretMap["expiration_date"] = secret.ExpirationDate.String()
+	}
+	if secret.NextRotationDate != nil {
+		secretMap["next_rotation_date"] = secret.NextRotationDate.String()
+	}
+	return secretMap
+}
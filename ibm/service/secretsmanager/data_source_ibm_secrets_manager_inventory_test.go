@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerInventoryDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerInventoryDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_secrets_manager_inventory.inventory", "id", acc.SecretsManagerInstanceID),
+					resource.TestCheckResourceAttrSet("data.ibm_secrets_manager_inventory.inventory", "total"),
+					resource.TestCheckResourceAttr("data.ibm_secrets_manager_inventory.inventory", "secrets.0.secret_type", acc.SecretsManagerSecretType),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerInventoryDataSourceConfigBasic() string {
+	return fmt.Sprintf(`
+		data "ibm_secrets_manager_inventory" "inventory" {
+			instance_id = "%s"
+			secret_type = "%s"
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerSecretType)
+}
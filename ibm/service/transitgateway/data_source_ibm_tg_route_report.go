@@ -71,6 +71,16 @@ func DataSourceIBMTransitGatewayRouteReport() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						tgRouteReportConnectionRouteCount: {
+							Type:        schema.TypeInt,
+							Description: "Number of the connection's used routes",
+							Computed:    true,
+						},
+						tgRouteReportConnectionBgpCount: {
+							Type:        schema.TypeInt,
+							Description: "Number of the connection's bgps",
+							Computed:    true,
+						},
 						tgRouteReportConnectionRoutes: {
 							Type:        schema.TypeList,
 							Description: "Collection of transit gateway connection's used routes",
@@ -204,6 +214,8 @@ func dataSourceIBMTransitGatewayRouteReportRead(d *schema.ResourceData, meta int
 			routes = append(routes, tgConnRoute)
 		}
 		tgConn[tgRouteReportConnectionRoutes] = routes
+		tgConn[tgRouteReportConnectionRouteCount] = len(routes)
+		tgConn[tgRouteReportConnectionBgpCount] = len(bgps)
 
 		connections = append(connections, tgConn)
 	}
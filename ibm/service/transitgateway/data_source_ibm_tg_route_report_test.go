@@ -23,6 +23,7 @@ func TestAccIBMTransitGatewayRouteReportDataSource_basic(t *testing.T) {
 				Config: testAccCheckIBMTransitGatewayDataRouteReportSourceConfig(gatewayname, location),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.ibm_tg_route_report.test_tg_route_get", "connections.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_tg_route_report.test_tg_route_get", "connections.0.route_count"),
 				),
 			},
 		},
@@ -23,6 +23,8 @@ const (
 	tgRouteReportConnectionBgpPrefix          = "prefix"
 	tgRouteReportConnectionRoutes             = "routes"
 	tgRouteReportConnectionRoutePrefix        = "prefix"
+	tgRouteReportConnectionRouteCount         = "route_count"
+	tgRouteReportConnectionBgpCount           = "bgp_count"
 	tgRouteReportConnectionType               = "type"
 	tgRouteReportOverlappingPrefix            = "prefix"
 )
@@ -93,6 +95,16 @@ func DataSourceIBMTransitGatewayRouteReports() *schema.Resource {
 										Type:     schema.TypeString,
 										Computed: true,
 									},
+									tgRouteReportConnectionRouteCount: {
+										Type:        schema.TypeInt,
+										Description: "Number of the connection's used routes",
+										Computed:    true,
+									},
+									tgRouteReportConnectionBgpCount: {
+										Type:        schema.TypeInt,
+										Description: "Number of the connection's bgps",
+										Computed:    true,
+									},
 									tgRouteReportConnectionRoutes: {
 										Type:        schema.TypeList,
 										Description: "Collection of transit gateway connection's used routes",
@@ -231,6 +243,8 @@ func dataSourceIBMTransitGatewayRouteReportsRead(d *schema.ResourceData, meta in
 				routes = append(routes, tgConnRoute)
 			}
 			tgConn[tgRouteReportConnectionRoutes] = routes
+			tgConn[tgRouteReportConnectionRouteCount] = len(routes)
+			tgConn[tgRouteReportConnectionBgpCount] = len(bgps)
 
 			connections = append(connections, tgConn)
 		}
@@ -6,6 +6,7 @@ package transitgateway
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -15,7 +16,8 @@ import (
 )
 
 const (
-	tgRouteReportId = "route_report_id"
+	tgRouteReportId                      = "route_report_id"
+	tgRouteReportFailOnOverlappingRoutes = "fail_on_overlapping_routes"
 
 	isTransitGatewayRouteReportPending = "pending"
 	isTransitGatewayRouteReportDone    = "complete"
@@ -45,6 +47,13 @@ func ResourceIBMTransitGatewayRouteReport() *schema.Resource {
 				Computed:    true,
 				Description: "The Transit Gateway Route Report identifier",
 			},
+			tgRouteReportFailOnOverlappingRoutes: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Fail the apply when the completed route report contains overlapping routes",
+			},
 			tgRouteReportConnections: {
 				Type:        schema.TypeList,
 				Description: "Collection of transit gateway connections",
@@ -88,6 +97,16 @@ func ResourceIBMTransitGatewayRouteReport() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						tgRouteReportConnectionRouteCount: {
+							Type:        schema.TypeInt,
+							Description: "Number of the connection's used routes",
+							Computed:    true,
+						},
+						tgRouteReportConnectionBgpCount: {
+							Type:        schema.TypeInt,
+							Description: "Number of the connection's bgps",
+							Computed:    true,
+						},
 						tgRouteReportConnectionRoutes: {
 							Type:        schema.TypeList,
 							Description: "Collection of transit gateway connection's used routes",
@@ -165,11 +184,26 @@ func resourceIBMTransitGatewayRouteReportCreate(d *schema.ResourceData, meta int
 	d.SetId(fmt.Sprintf("%s/%s", gatewayId, *tgRouteReport.ID))
 	d.Set(tgRouteReportId, *tgRouteReport.ID)
 
-	_, err = isWaitForTransitGatewayRouteReportAvailable(client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	report, err := isWaitForTransitGatewayRouteReportAvailable(client, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 
+	if d.Get(tgRouteReportFailOnOverlappingRoutes).(bool) {
+		if routeReport, ok := report.(*transitgatewayapisv1.RouteReport); ok && len(routeReport.OverlappingRoutes) > 0 {
+			overlaps := make([]string, 0)
+			for _, overlap := range routeReport.OverlappingRoutes {
+				for _, route := range overlap.Routes {
+					if route.ConnectionID != nil && route.Prefix != nil {
+						overlaps = append(overlaps, fmt.Sprintf("%s (connection %s)", *route.Prefix, *route.ConnectionID))
+					}
+				}
+			}
+			// The report is kept in state so that the next apply generates a fresh one.
+			return fmt.Errorf("[ERROR] Transit Gateway Route Report (%s) found overlapping routes: %s", *tgRouteReport.ID, strings.Join(overlaps, ", "))
+		}
+	}
+
 	return resourceIBMTransitGatewayRouteReportRead(d, meta)
 }
 
@@ -303,6 +337,8 @@ func resourceIBMTransitGatewayRouteReportRead(d *schema.ResourceData, meta inter
 			routes = append(routes, tgConnRoute)
 		}
 		tgConn[tgRouteReportConnectionRoutes] = routes
+		tgConn[tgRouteReportConnectionRouteCount] = len(routes)
+		tgConn[tgRouteReportConnectionBgpCount] = len(bgps)
 
 		connections = append(connections, tgConn)
 	}
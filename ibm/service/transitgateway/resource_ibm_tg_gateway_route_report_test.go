@@ -37,6 +37,28 @@ func TestAccIBMTransitGatewayRouteReport_basic(t *testing.T) {
 	)
 }
 
+func TestAccIBMTransitGatewayRouteReport_failOnOverlappingRoutes(t *testing.T) {
+	var tgRouteReport string
+	gatewayName := fmt.Sprintf("tg-gateway-name-%d", acctest.RandIntRange(10, 100))
+	location := fmt.Sprintf("us-south")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMTransitGatewayRouteReportDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMTransitGatewayRouteReportFailOnOverlappingRoutesConfig(gatewayName, location),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMTransitGatewayRouteReportExists("ibm_tg_route_report.test_tg_route", tgRouteReport),
+					resource.TestCheckResourceAttr("ibm_tg_route_report.test_tg_route", "fail_on_overlapping_routes", "true"),
+					resource.TestCheckResourceAttr("ibm_tg_route_report.test_tg_route", "overlapping_routes.#", "0"),
+				),
+			},
+		},
+	},
+	)
+}
+
 func testAccCheckIBMTransitGatewayRouteReportConfig(gatewayname, location string) string {
 	return fmt.Sprintf(`
 	
@@ -52,6 +74,22 @@ func testAccCheckIBMTransitGatewayRouteReportConfig(gatewayname, location string
 	`, gatewayname, location)
 }
 
+func testAccCheckIBMTransitGatewayRouteReportFailOnOverlappingRoutesConfig(gatewayname, location string) string {
+	return fmt.Sprintf(`
+	
+	resource "ibm_tg_gateway" "test_tg_gateway" {
+		name="%s"
+		location="%s"
+		global=true
+	}
+
+	resource "ibm_tg_route_report" "test_tg_route" {
+		gateway                    = ibm_tg_gateway.test_tg_gateway.id
+		fail_on_overlapping_routes = true
+	}
+	`, gatewayname, location)
+}
+
 func testAccCheckIBMTransitGatewayRouteReportDestroy(s *terraform.State) error {
 	client, err := transitgatewayClient(acc.TestAccProvider.Meta())
 	if err != nil {
@@ -0,0 +1,520 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package transitgateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	tgVpcPeeringVpcCrns                  = "vpc_crns"
+	tgVpcPeeringCheckOverlappingPrefixes = "check_overlapping_prefixes"
+	tgVpcPeeringPeered                   = "peered"
+	tgVpcPeeringConnections              = "connections"
+)
+
+// ResourceIBMTransitGatewayVpcPeering creates a transit gateway together with
+// one VPC connection per given VPC CRN, so that a hub and spoke setup does not
+// need a gateway and a connection resource per VPC.
+func ResourceIBMTransitGatewayVpcPeering() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMTransitGatewayVpcPeeringCreate,
+		Read:     resourceIBMTransitGatewayVpcPeeringRead,
+		Update:   resourceIBMTransitGatewayVpcPeeringUpdate,
+		Delete:   resourceIBMTransitGatewayVpcPeeringDelete,
+		Exists:   resourceIBMTransitGatewayExists,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			tgName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_tg_gateway", tgName),
+				Description:  "Name of the Transit Gateway",
+			},
+			tgLocation: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Location of the Transit Gateway",
+			},
+			tgGlobal: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Allow global routing for the Transit Gateway. Required to peer VPCs of different regions",
+			},
+			tgResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The resource group of the Transit Gateway",
+			},
+			tgVpcPeeringVpcCrns: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    2,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The CRNs of the VPCs to peer through the Transit Gateway",
+			},
+			tgVpcPeeringCheckOverlappingPrefixes: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Compare the address prefixes of the VPCs before they are attached and fail the apply if they overlap",
+			},
+			tgCrn: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The crn of the Transit Gateway",
+			},
+			tgStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the Transit Gateway",
+			},
+			tgVpcPeeringPeered: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the Transit Gateway is available and all VPC connections are attached",
+			},
+			tgVpcPeeringConnections: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The VPC connections of the Transit Gateway",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						tgConnectionId: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Transit Gateway Connection identifier",
+						},
+						tgName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the connection",
+						},
+						tgNetworkId: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the connected VPC",
+						},
+						tgStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the connection",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMTransitGatewayVpcPeeringCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := transitgatewayClient(meta)
+	if err != nil {
+		return err
+	}
+
+	name := d.Get(tgName).(string)
+	location := d.Get(tgLocation).(string)
+	global := d.Get(tgGlobal).(bool)
+	createTransitGatewayOptions := &transitgatewayapisv1.CreateTransitGatewayOptions{
+		Name:     &name,
+		Location: &location,
+		Global:   &global,
+	}
+	if rsg, ok := d.GetOk(tgResourceGroup); ok {
+		resourceGroup := rsg.(string)
+		createTransitGatewayOptions.ResourceGroup = &transitgatewayapisv1.ResourceGroupIdentity{ID: &resourceGroup}
+	}
+
+	vpcCrns := flex.ExpandStringList(d.Get(tgVpcPeeringVpcCrns).(*schema.Set).List())
+	if d.Get(tgVpcPeeringCheckOverlappingPrefixes).(bool) {
+		err = tgVpcPeeringCheckOverlaps(meta, name, vpcCrns)
+		if err != nil {
+			return err
+		}
+	}
+
+	tgw, response, err := client.CreateTransitGateway(createTransitGatewayOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating Transit Gateway %s: %s\n%s", name, err, response)
+	}
+	d.SetId(*tgw.ID)
+
+	_, err = isWaitForTransitGatewayAvailable(client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	for _, vpcCrn := range vpcCrns {
+		err = tgVpcPeeringAttachVpc(client, d.Id(), vpcCrn, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMTransitGatewayVpcPeeringRead(d, meta)
+}
+
+func resourceIBMTransitGatewayVpcPeeringRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := transitgatewayClient(meta)
+	if err != nil {
+		return err
+	}
+
+	id := d.Id()
+	tgw, response, err := client.GetTransitGateway(&transitgatewayapisv1.GetTransitGatewayOptions{ID: &id})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting Transit Gateway (%s): %s\n%s", id, err, response)
+	}
+	d.Set(tgName, tgw.Name)
+	d.Set(tgLocation, tgw.Location)
+	d.Set(tgGlobal, tgw.Global)
+	d.Set(tgCrn, tgw.Crn)
+	d.Set(tgStatus, tgw.Status)
+	if tgw.ResourceGroup != nil {
+		d.Set(tgResourceGroup, *tgw.ResourceGroup.ID)
+	}
+
+	vpcConnections, err := tgVpcPeeringListVpcConnections(client, id)
+	if err != nil {
+		return err
+	}
+	peered := *tgw.Status == "available"
+	vpcCrns := make([]string, 0, len(vpcConnections))
+	connections := make([]map[string]interface{}, 0, len(vpcConnections))
+	for _, conn := range vpcConnections {
+		vpcCrns = append(vpcCrns, *conn.NetworkID)
+		connections = append(connections, map[string]interface{}{
+			tgConnectionId: *conn.ID,
+			tgName:         *conn.Name,
+			tgNetworkId:    *conn.NetworkID,
+			tgStatus:       *conn.Status,
+		})
+		if *conn.Status != isTransitGatewayConnectionAttached {
+			peered = false
+		}
+	}
+	d.Set(tgVpcPeeringVpcCrns, vpcCrns)
+	d.Set(tgVpcPeeringConnections, connections)
+	d.Set(tgVpcPeeringPeered, peered)
+	return nil
+}
+
+func resourceIBMTransitGatewayVpcPeeringUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := transitgatewayClient(meta)
+	if err != nil {
+		return err
+	}
+	id := d.Id()
+
+	if d.HasChanges(tgName, tgGlobal) {
+		updateTransitGatewayOptions := &transitgatewayapisv1.UpdateTransitGatewayOptions{ID: &id}
+		if d.HasChange(tgName) {
+			name := d.Get(tgName).(string)
+			updateTransitGatewayOptions.Name = &name
+		}
+		if d.HasChange(tgGlobal) {
+			global := d.Get(tgGlobal).(bool)
+			updateTransitGatewayOptions.Global = &global
+		}
+		_, response, err := client.UpdateTransitGateway(updateTransitGatewayOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating Transit Gateway (%s): %s\n%s", id, err, response)
+		}
+	}
+
+	if d.HasChange(tgVpcPeeringVpcCrns) {
+		o, n := d.GetChange(tgVpcPeeringVpcCrns)
+		removed := flex.ExpandStringList(o.(*schema.Set).Difference(n.(*schema.Set)).List())
+		added := flex.ExpandStringList(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+
+		if len(added) > 0 && d.Get(tgVpcPeeringCheckOverlappingPrefixes).(bool) {
+			err = tgVpcPeeringCheckOverlaps(meta, id, flex.ExpandStringList(n.(*schema.Set).List()))
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(removed) > 0 {
+			vpcConnections, err := tgVpcPeeringListVpcConnections(client, id)
+			if err != nil {
+				return err
+			}
+			for _, vpcCrn := range removed {
+				for _, conn := range vpcConnections {
+					if *conn.NetworkID == vpcCrn {
+						err = tgVpcPeeringDetachConnection(client, id, *conn.ID, d.Timeout(schema.TimeoutUpdate))
+						if err != nil {
+							return err
+						}
+					}
+				}
+			}
+		}
+		for _, vpcCrn := range added {
+			err = tgVpcPeeringAttachVpc(client, id, vpcCrn, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceIBMTransitGatewayVpcPeeringRead(d, meta)
+}
+
+func resourceIBMTransitGatewayVpcPeeringDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := transitgatewayClient(meta)
+	if err != nil {
+		return err
+	}
+	id := d.Id()
+
+	vpcConnections, err := tgVpcPeeringListVpcConnections(client, id)
+	if err != nil {
+		return err
+	}
+	for _, conn := range vpcConnections {
+		err = tgVpcPeeringDetachConnection(client, id, *conn.ID, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return err
+		}
+	}
+
+	response, err := client.DeleteTransitGateway(&transitgatewayapisv1.DeleteTransitGatewayOptions{ID: &id})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error deleting Transit Gateway (%s): %s\n%s", id, err, response)
+	}
+	_, err = isWaitForTransitGatewayDeleted(client, id, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func tgVpcPeeringListVpcConnections(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayID string) ([]transitgatewayapisv1.TransitGatewayConnectionCust, error) {
+	start := ""
+	vpcConnections := make([]transitgatewayapisv1.TransitGatewayConnectionCust, 0)
+	for {
+		connections, next, response, err := tgVpcPeeringListConnectionsPage(client, gatewayID, start)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing connections of Transit Gateway (%s): %s\n%s", gatewayID, err, response)
+		}
+		for _, conn := range connections {
+			if conn.NetworkType != nil && *conn.NetworkType == "vpc" && conn.NetworkID != nil {
+				vpcConnections = append(vpcConnections, conn)
+			}
+		}
+		start = next
+		if start == "" {
+			break
+		}
+	}
+	return vpcConnections, nil
+}
+
+// tgVpcPeeringListConnectionsPage lists one page of the connections of a
+// gateway. ListTransitGatewayConnections of the SDK has no start and limit
+// options, so the request is built the same way with the paging queries.
+func tgVpcPeeringListConnectionsPage(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayID, start string) ([]transitgatewayapisv1.TransitGatewayConnectionCust, string, *core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(core.GET)
+	builder.EnableGzipCompression = client.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(client.Service.Options.URL, `/transit_gateways/{transit_gateway_id}/connections`, map[string]string{"transit_gateway_id": gatewayID})
+	if err != nil {
+		return nil, "", nil, err
+	}
+	for headerName, headerValue := range common.GetSdkHeaders("transit_gateway_apis", "V1", "ListTransitGatewayConnections") {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddQuery("version", fmt.Sprint(*client.Version))
+	builder.AddQuery("limit", "50")
+	if start != "" {
+		builder.AddQuery("start", start)
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err := client.Service.Request(request, &rawResponse)
+	if err != nil {
+		return nil, "", response, err
+	}
+	var connections []transitgatewayapisv1.TransitGatewayConnectionCust
+	err = core.UnmarshalModel(rawResponse, "connections", &connections, transitgatewayapisv1.UnmarshalTransitGatewayConnectionCust)
+	if err != nil {
+		return nil, "", response, err
+	}
+	next := struct {
+		Start *string `json:"start"`
+	}{}
+	if rawNext, ok := rawResponse["next"]; ok {
+		if err = json.Unmarshal(rawNext, &next); err != nil {
+			return nil, "", response, err
+		}
+	}
+	if next.Start == nil {
+		return connections, "", response, nil
+	}
+	return connections, *next.Start, response, nil
+}
+
+func tgVpcPeeringAttachVpc(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayID, vpcCrn string, timeout time.Duration) error {
+	createTransitGatewayConnectionOptions := &transitgatewayapisv1.CreateTransitGatewayConnectionOptions{}
+	createTransitGatewayConnectionOptions.SetTransitGatewayID(gatewayID)
+	createTransitGatewayConnectionOptions.SetNetworkType("vpc")
+	createTransitGatewayConnectionOptions.SetNetworkID(vpcCrn)
+
+	tgConnection, response, err := client.CreateTransitGatewayConnection(createTransitGatewayConnectionOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error attaching VPC %s to Transit Gateway (%s): %s\n%s", vpcCrn, gatewayID, err, response)
+	}
+	log.Printf("[DEBUG] Attaching VPC %s to Transit Gateway (%s) with connection %s", vpcCrn, gatewayID, *tgConnection.ID)
+	_, err = isWaitForTransitGatewayConnectionAvailable(client, fmt.Sprintf("%s/%s", gatewayID, *tgConnection.ID), timeout)
+	return err
+}
+
+func tgVpcPeeringDetachConnection(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayID, connectionID string, timeout time.Duration) error {
+	deleteTransitGatewayConnectionOptions := &transitgatewayapisv1.DeleteTransitGatewayConnectionOptions{}
+	deleteTransitGatewayConnectionOptions.SetTransitGatewayID(gatewayID)
+	deleteTransitGatewayConnectionOptions.SetID(connectionID)
+	response, err := client.DeleteTransitGatewayConnection(deleteTransitGatewayConnectionOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error deleting Transit Gateway Connection (%s): %s\n%s", connectionID, err, response)
+	}
+	_, err = isWaitForTransitGatewayConnectionDeleted(client, fmt.Sprintf("%s/%s", gatewayID, connectionID), timeout)
+	return err
+}
+
+// tgVpcPeeringCheckOverlaps returns an error listing the address prefixes of
+// the VPCs that overlap with a prefix of another VPC, if any. It runs before
+// the VPCs are attached so that nothing is created for overlapping VPCs.
+func tgVpcPeeringCheckOverlaps(meta interface{}, gateway string, vpcCrns []string) error {
+	prefixes := make(map[string][]string, len(vpcCrns))
+	for _, vpcCrn := range vpcCrns {
+		vpcPrefixes, err := tgVpcPeeringVpcPrefixes(meta, vpcCrn)
+		if err != nil {
+			return err
+		}
+		prefixes[vpcCrn] = vpcPrefixes
+	}
+	overlaps := tgVpcPeeringOverlappingPrefixes(prefixes)
+	if len(overlaps) == 0 {
+		return nil
+	}
+	return fmt.Errorf("[ERROR] The VPCs to attach to Transit Gateway (%s) have overlapping prefixes: %s", gateway, strings.Join(overlaps, ", "))
+}
+
+// tgVpcPeeringOverlappingPrefixes returns the prefixes, with their VPC, that
+// overlap with a prefix of another VPC.
+func tgVpcPeeringOverlappingPrefixes(prefixes map[string][]string) []string {
+	vpcCrns := make([]string, 0, len(prefixes))
+	for vpcCrn := range prefixes {
+		vpcCrns = append(vpcCrns, vpcCrn)
+	}
+	sort.Strings(vpcCrns)
+
+	overlaps := make([]string, 0)
+	for _, vpcCrn := range vpcCrns {
+		for _, prefix := range prefixes[vpcCrn] {
+			_, prefixNet, err := net.ParseCIDR(prefix)
+			if err != nil {
+				continue
+			}
+			overlapping := false
+			for _, otherCrn := range vpcCrns {
+				if otherCrn == vpcCrn {
+					continue
+				}
+				for _, other := range prefixes[otherCrn] {
+					_, otherNet, err := net.ParseCIDR(other)
+					if err == nil && (prefixNet.Contains(otherNet.IP) || otherNet.Contains(prefixNet.IP)) {
+						overlapping = true
+					}
+				}
+			}
+			if overlapping {
+				overlaps = append(overlaps, fmt.Sprintf("%s (vpc %s)", prefix, vpcCrn))
+			}
+		}
+	}
+	return overlaps
+}
+
+// tgVpcPeeringVpcPrefixes lists the address prefixes of a VPC in the region
+// of its CRN.
+func tgVpcPeeringVpcPrefixes(meta interface{}, vpcCrn string) ([]string, error) {
+	crnParts := strings.Split(vpcCrn, ":")
+	if len(crnParts) != 10 || crnParts[8] != "vpc" || crnParts[9] == "" {
+		return nil, fmt.Errorf("[ERROR] Invalid VPC CRN %s", vpcCrn)
+	}
+	vpcClient, err := meta.(conns.ClientSession).VpcV1APIForRegion(crnParts[5])
+	if err != nil {
+		return nil, err
+	}
+	start := ""
+	prefixes := make([]string, 0)
+	for {
+		listVPCAddressPrefixesOptions := &vpcv1.ListVPCAddressPrefixesOptions{
+			VPCID: &crnParts[9],
+		}
+		if start != "" {
+			listVPCAddressPrefixesOptions.Start = &start
+		}
+		addressPrefixCollection, response, err := vpcClient.ListVPCAddressPrefixes(listVPCAddressPrefixesOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing address prefixes of VPC %s: %s\n%s", vpcCrn, err, response)
+		}
+		for _, addressPrefix := range addressPrefixCollection.AddressPrefixes {
+			if addressPrefix.CIDR != nil {
+				prefixes = append(prefixes, *addressPrefix.CIDR)
+			}
+		}
+		start = flex.GetNext(addressPrefixCollection.Next)
+		if start == "" {
+			break
+		}
+	}
+	return prefixes, nil
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package transitgateway
+
+import (
+	"fmt"
+	gohttp "net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+)
+
+func TestTgVpcPeeringOverlappingPrefixes(t *testing.T) {
+	cases := []struct {
+		name     string
+		prefixes map[string][]string
+		want     []string
+	}{
+		{
+			name:     "disjoint",
+			prefixes: map[string][]string{"vpc-a": {"10.0.0.0/24"}, "vpc-b": {"10.0.1.0/24"}},
+			want:     []string{},
+		},
+		{
+			name:     "same vpc",
+			prefixes: map[string][]string{"vpc-a": {"10.0.0.0/16", "10.0.1.0/24"}, "vpc-b": {"10.1.0.0/16"}},
+			want:     []string{},
+		},
+		{
+			name:     "contained",
+			prefixes: map[string][]string{"vpc-a": {"10.0.0.0/16", "172.16.0.0/24"}, "vpc-b": {"10.0.5.0/24"}},
+			want:     []string{"10.0.0.0/16 (vpc vpc-a)", "10.0.5.0/24 (vpc vpc-b)"},
+		},
+	}
+	for _, c := range cases {
+		got := tgVpcPeeringOverlappingPrefixes(c.prefixes)
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: got %q, want %q", c.name, got, c.want)
+		}
+	}
+}
+
+func TestTgVpcPeeringListVpcConnections(t *testing.T) {
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("start") == "" {
+			fmt.Fprintf(w, `{"connections": [{"id": "c1", "network_type": "vpc", "network_id": "crn-1"}, {"id": "c2", "network_type": "classic"}], "next": {"start": "page2"}}`)
+			return
+		}
+		fmt.Fprintf(w, `{"connections": [{"id": "c3", "network_type": "vpc", "network_id": "crn-3"}]}`)
+	}))
+	defer server.Close()
+
+	client, err := transitgatewayapisv1.NewTransitGatewayApisV1(&transitgatewayapisv1.TransitGatewayApisV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+		Version:       core.StringPtr("2021-03-31"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	connections, err := tgVpcPeeringListVpcConnections(client, "gateway")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := []string{}
+	for _, conn := range connections {
+		ids = append(ids, *conn.ID)
+	}
+	if want := []string{"c1", "c3"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("got %q, want %q", ids, want)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package transitgateway_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMTransitGatewayVpcPeering_basic(t *testing.T) {
+	gatewayname := fmt.Sprintf("tg-peering-name-%d", acctest.RandIntRange(10, 100))
+	vpcname := fmt.Sprintf("tg-peering-vpc-%d", acctest.RandIntRange(10, 100))
+	location := "us-south"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMTransitGatewayVpcPeeringConfig(vpcname, gatewayname, location, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_tg_vpc_peering.test_tg_peering", "name", gatewayname),
+					resource.TestCheckResourceAttr("ibm_tg_vpc_peering.test_tg_peering", "vpc_crns.#", "2"),
+					resource.TestCheckResourceAttr("ibm_tg_vpc_peering.test_tg_peering", "connections.#", "2"),
+					resource.TestCheckResourceAttr("ibm_tg_vpc_peering.test_tg_peering", "peered", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMTransitGatewayVpcPeeringConfig(vpcname, gatewayname, location, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_tg_vpc_peering.test_tg_peering", "vpc_crns.#", "3"),
+					resource.TestCheckResourceAttr("ibm_tg_vpc_peering.test_tg_peering", "connections.#", "3"),
+					resource.TestCheckResourceAttr("ibm_tg_vpc_peering.test_tg_peering", "peered", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMTransitGatewayVpcPeeringConfig(vpcname, gatewayname, location string, count int) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "test_tg_peering_vpc" {
+		count                     = %d
+		name                      = "%s-${count.index}"
+		address_prefix_management = "manual"
+	}
+
+	resource "ibm_tg_vpc_peering" "test_tg_peering" {
+		name     = "%s"
+		location = "%s"
+		vpc_crns = ibm_is_vpc.test_tg_peering_vpc[*].crn
+	}
+	`, count, vpcname, gatewayname, location)
+}
@@ -28,6 +28,9 @@ const (
 	isVPEResourceType                     = "resource_type"
 	isVPEResourceFullQualifiedDomainNames = "full_qualified_domain_names"
 	isVPEResourceServiceLocation          = "location"
+	isVPEResourceServiceName              = "service_name"
+	isVPEResourceRegion                   = "region"
+	isVPEResourceCRNs                     = "crns"
 )
 
 func DataSourceIBMISEndpointGatewayTargets() *schema.Resource {
@@ -35,6 +38,38 @@ func DataSourceIBMISEndpointGatewayTargets() *schema.Resource {
 		ReadContext: dataSourceIBMISEndpointGatewayTargetsRead,
 
 		Schema: map[string]*schema.Schema{
+			isVPEResourceServiceName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the targets of this service, for example cloud-object-storage",
+			},
+			isVPEResourceRegion: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the targets in this region, the region of the provider by default",
+			},
+			isVPEResourceType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the targets of this resource type, for example provider_cloud_service",
+			},
+			isVPEResourceEndpointType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the targets with this data endpoint type, for example public or private",
+			},
+			isVPEResourceCRNs: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "CRNs of the returned targets that have a CRN",
+			},
+			isVPEResourceFullQualifiedDomainNames: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Fully qualified domain names of all the returned targets",
+			},
 			isVPEResources: {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -77,6 +112,11 @@ func DataSourceIBMISEndpointGatewayTargets() *schema.Resource {
 							Computed:    true,
 							Description: "Service location of this offering",
 						},
+						isVPEResourceServiceName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Service name of this offering",
+						},
 					},
 				},
 			},
@@ -90,6 +130,11 @@ func dataSourceIBMISEndpointGatewayTargetsRead(context context.Context, d *schem
 		return diag.FromErr(err)
 	}
 	region := bmxSess.Config.Region
+	regionFilter := ""
+	if r, ok := d.GetOk(isVPEResourceRegion); ok {
+		region = r.(string)
+		regionFilter = region
+	}
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
 		return diag.FromErr(err)
@@ -144,6 +189,7 @@ func dataSourceIBMISEndpointGatewayTargetsRead(context context.Context, d *schem
 						l[isVPEResourceCRN] = serviceCrn
 						crnFs := strings.Split(serviceCrn, ":")
 						if len(crnFs) > 5 {
+							l[isVPEResourceServiceName] = crnFs[4]
 							sl = crnFs[5]
 						}
 						l[isVPEResourceServiceLocation] = sl
@@ -184,14 +230,64 @@ func dataSourceIBMISEndpointGatewayTargetsRead(context context.Context, d *schem
 		info[isVPEResourceName] = item.Name
 		info[isVPEResourceType] = item.Type
 		info[isVPEResourceCRN] = item.CRN
+		info[isVPEResourceServiceName] = item.ServiceName
+		if crnFs := strings.Split(item.CRN, ":"); item.ServiceName == "" && len(crnFs) > 5 {
+			info[isVPEResourceServiceName] = crnFs[4]
+		}
+		if regionFilter != "" && item.Region != regionFilter {
+			continue
+		}
 		resourceInfo = append(resourceInfo, info)
 
 	}
 
+	resourceInfo = filterIBMISEndpointGatewayTargets(d, resourceInfo)
+	crns := []string{}
+	fqdns := []string{}
+	for _, info := range resourceInfo {
+		if crn, ok := info[isVPEResourceCRN].(string); ok && crn != "" {
+			crns = append(crns, crn)
+		}
+		if names, ok := info[isVPEResourceFullQualifiedDomainNames].([]interface{}); ok {
+			for _, name := range names {
+				if name, ok := name.(string); ok {
+					fqdns = append(fqdns, name)
+				}
+			}
+		}
+	}
+
 	d.Set(isVPEResources, resourceInfo)
+	d.Set(isVPEResourceCRNs, crns)
+	d.Set(isVPEResourceFullQualifiedDomainNames, fqdns)
 	d.SetId(dataSourceIBMISEndpointGatewayTargetsId(d))
 	return nil
 }
+
+// filterIBMISEndpointGatewayTargets returns the targets that match the
+// service_name, resource_type and endpoint_type filters. The service name
+// matches the service of the CRN or, for targets without a CRN such as
+// ibm-ntp-server, the name of the target.
+func filterIBMISEndpointGatewayTargets(d *schema.ResourceData, resourceInfo []map[string]interface{}) []map[string]interface{} {
+	serviceName := d.Get(isVPEResourceServiceName).(string)
+	resourceType := d.Get(isVPEResourceType).(string)
+	endpointType := d.Get(isVPEResourceEndpointType).(string)
+	filtered := make([]map[string]interface{}, 0, len(resourceInfo))
+	for _, info := range resourceInfo {
+		if serviceName != "" && info[isVPEResourceServiceName] != serviceName && info[isVPEResourceName] != serviceName {
+			continue
+		}
+		if resourceType != "" && info[isVPEResourceType] != resourceType {
+			continue
+		}
+		if endpointType != "" && info[isVPEResourceEndpointType] != endpointType {
+			continue
+		}
+		filtered = append(filtered, info)
+	}
+	return filtered
+}
+
 func dataSourceIBMISEndpointGatewayTargetsId(d *schema.ResourceData) string {
 	return time.Now().UTC().String()
 }
@@ -29,6 +29,33 @@ func TestAccIBMISEndpointGatewayTargetsDataSource_basics(t *testing.T) {
 	})
 }
 
+func TestAccIBMISEndpointGatewayTargetsDataSource_filter(t *testing.T) {
+	resName := "data.ibm_is_endpoint_gateway_targets.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISEgtsDataSourceFilterConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "resources.0.service_name", "cloud-object-storage"),
+					resource.TestCheckResourceAttrSet(resName, "crns.0"),
+					resource.TestCheckResourceAttrSet(resName, "full_qualified_domain_names.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISEgtsDataSourceFilterConfig() string {
+	return fmt.Sprintf(`
+	data "ibm_is_endpoint_gateway_targets" "test" {
+		service_name  = "cloud-object-storage"
+		resource_type = "provider_cloud_service"
+	}
+	`)
+}
+
 func testAccCheckIBMISEgtsDataSourceConfig() string {
 
 	return fmt.Sprintf(`
@@ -10,6 +10,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
@@ -22,6 +23,52 @@ func DataSourceIBMISFlowLogs() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 
+			"resource_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the resource group to filter the flow log collectors",
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the flow log collector to filter the collection",
+			},
+
+			"vpc": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"vpc_crn", "vpc_name"},
+				Description:   "The ID of the VPC to filter the flow log collectors",
+			},
+
+			"vpc_crn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"vpc", "vpc_name"},
+				Description:   "The CRN of the VPC to filter the flow log collectors",
+			},
+
+			"vpc_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"vpc", "vpc_crn"},
+				Description:   "The name of the VPC to filter the flow log collectors",
+			},
+
+			"target": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the target resource to filter the flow log collectors",
+			},
+
+			"target_resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"instance", "network_interface", "subnet", "vpc"}, false),
+				Description:  "The type of the target resource to filter the flow log collectors (instance, network_interface, subnet or vpc)",
+			},
+
 			isFlowLogs: {
 				Type:        schema.TypeList,
 				Description: "Collection of flow log collectors",
@@ -78,6 +125,11 @@ func DataSourceIBMISFlowLogs() *schema.Resource {
 							Computed:    true,
 							Description: "The target id that the flow log collector is to collect flow logs",
 						},
+						"target_resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the target resource of the flow log collector",
+						},
 						"vpc": {
 							Type:        schema.TypeString,
 							Computed:    true,
@@ -96,10 +148,32 @@ func dataSourceIBMISFlowLogsRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	listOptions := &vpcv1.ListFlowLogCollectorsOptions{}
+	if v, ok := d.GetOk("resource_group"); ok {
+		listOptions.SetResourceGroupID(v.(string))
+	}
+	if v, ok := d.GetOk("name"); ok {
+		listOptions.SetName(v.(string))
+	}
+	if v, ok := d.GetOk("vpc"); ok {
+		listOptions.SetVPCID(v.(string))
+	}
+	if v, ok := d.GetOk("vpc_crn"); ok {
+		listOptions.SetVPCCRN(v.(string))
+	}
+	if v, ok := d.GetOk("vpc_name"); ok {
+		listOptions.SetVPCName(v.(string))
+	}
+	if v, ok := d.GetOk("target"); ok {
+		listOptions.SetTargetID(v.(string))
+	}
+	if v, ok := d.GetOk("target_resource_type"); ok {
+		listOptions.SetTargetResourceType(v.(string))
+	}
+
 	start := ""
 	allrecs := []vpcv1.FlowLogCollector{}
 	for {
-		listOptions := &vpcv1.ListFlowLogCollectorsOptions{}
 		if start != "" {
 			listOptions.Start = &start
 		}
@@ -132,6 +206,9 @@ func dataSourceIBMISFlowLogsRead(d *schema.ResourceData, meta interface{}) error
 			"vpc":             *flowlogCollector.VPC.ID,
 			"target":          *target.ID,
 		}
+		if target.ResourceType != nil {
+			l["target_resource_type"] = *target.ResourceType
+		}
 		flowlogsInfo = append(flowlogsInfo, l)
 	}
 	d.SetId(dataSourceIBMISFlowLogsID(d))
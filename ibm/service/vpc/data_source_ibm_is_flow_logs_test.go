@@ -56,6 +56,8 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 					resource.TestCheckResourceAttrSet(resName, "flow_log_collectors.0.crn"),
 					resource.TestCheckResourceAttrSet(resName, "flow_log_collectors.0.href"),
 					resource.TestCheckResourceAttrSet(resName, "flow_log_collectors.0.name"),
+					resource.TestCheckResourceAttr("data.ibm_is_flow_logs.filtered_flow_logs", "flow_log_collectors.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_is_flow_logs.filtered_flow_logs", "flow_log_collectors.0.name", flowlogname),
 				),
 			},
 		},
@@ -130,6 +132,11 @@ func testAccCheckIBMISFlowLogsDataSourceConfig(vpcname, name, flowlogname, sshna
 
 	  data "ibm_is_flow_logs" "display_flow_logs" {
 	}
+
+	  data "ibm_is_flow_logs" "filtered_flow_logs" {
+		vpc                  = ibm_is_flow_log.test_flow_log.vpc
+		target_resource_type = "instance"
+	}
 	  
 	  `, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, sshname, publicKey, name, acc.IsImage, acc.InstanceProfileName, acc.ISZoneName, serviceName, bucketName, bucketRegion, bucketClass, flowlogname, isActive)
 
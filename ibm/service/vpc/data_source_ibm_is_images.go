@@ -4,12 +4,14 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -20,7 +22,7 @@ const (
 
 func DataSourceIBMISImages() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISImagesRead,
+		ReadContext: dataSourceIBMISImagesRead,
 
 		Schema: map[string]*schema.Schema{
 			isImagesResourceGroupID: {
@@ -39,6 +41,13 @@ func DataSourceIBMISImages() *schema.Resource {
 				Optional:    true,
 				Description: "Whether the image is publicly visible or private to the account",
 			},
+			isImageStatus: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.ValidateAllowedStringValues([]string{"available", "deleting", "deprecated", "failed", "obsolete", "pending", "tentative", "unusable"})},
+				Set:         schema.HashString,
+				Description: "Only list the images in one of these statuses, for example available to exclude the deprecated and obsolete images",
+			},
 
 			isImages: {
 				Type:        schema.TypeList,
@@ -108,16 +117,16 @@ func DataSourceIBMISImages() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISImagesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISImagesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	err := imageList(d, meta)
+	err := imageList(context, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func imageList(d *schema.ResourceData, meta interface{}) error {
+func imageList(context context.Context, d *schema.ResourceData, meta interface{}) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -155,7 +164,7 @@ func imageList(d *schema.ResourceData, meta interface{}) error {
 		if start != "" {
 			listImagesOptions.Start = &start
 		}
-		availableImages, response, err := sess.ListImages(listImagesOptions)
+		availableImages, response, err := sess.ListImagesWithContext(context, listImagesOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Fetching Images %s\n%s", err, response)
 		}
@@ -165,8 +174,15 @@ func imageList(d *schema.ResourceData, meta interface{}) error {
 			break
 		}
 	}
+	var statuses *schema.Set
+	if v, ok := d.GetOk(isImageStatus); ok {
+		statuses = v.(*schema.Set)
+	}
 	imagesInfo := make([]map[string]interface{}, 0)
 	for _, image := range allrecs {
+		if statuses != nil && !statuses.Contains(*image.Status) {
+			continue
+		}
 
 		l := map[string]interface{}{
 			"name":         *image.Name,
@@ -49,6 +49,23 @@ func TestAccIBMISImageDataSource_With_FilterVisibilty(t *testing.T) {
 	})
 }
 
+func TestAccIBMISImageDataSource_With_FilterStatus(t *testing.T) {
+	resName := "data.ibm_is_images.test1"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISImagesDataSourceWithStatus("available"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "images.0.name"),
+					resource.TestCheckResourceAttr(resName, "images.0.status", "available"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISImagesDataSourceConfig() string {
 	// status filter defaults to empty
 	return fmt.Sprintf(`
@@ -63,3 +80,11 @@ func testAccCheckIBMISImagesDataSourceWithVisibilityPublic(visibility string) st
 	}
 	`, visibility)
 }
+
+func testAccCheckIBMISImagesDataSourceWithStatus(status string) string {
+	return fmt.Sprintf(`
+	data "ibm_is_images" "test1" {
+		status = ["%s"]
+	}
+	`, status)
+}
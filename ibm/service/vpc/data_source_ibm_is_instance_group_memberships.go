@@ -14,7 +14,8 @@ import (
 )
 
 const (
-	isInstanceGroupMemberships = "memberships"
+	isInstanceGroupMemberships                   = "memberships"
+	isInstanceGroupMembershipInstancePrimaryIpv4 = "primary_ipv4_address"
 )
 
 func DataSourceIBMISInstanceGroupMemberships() *schema.Resource {
@@ -64,6 +65,11 @@ func DataSourceIBMISInstanceGroupMemberships() *schema.Resource {
 										Computed:    true,
 										Description: "The user-defined name for this virtual server instance (and default system hostname).",
 									},
+									isInstanceGroupMembershipInstancePrimaryIpv4: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The primary IPv4 address of the primary network interface of this virtual server instance.",
+									},
 								},
 							},
 						},
@@ -159,6 +165,18 @@ func dataSourceIBMISInstanceGroupMembershipsRead(d *schema.ResourceData, meta in
 				isInstanceGroupMembershipVirtualServerInstance: *instanceGroupMembership.Instance.ID,
 				isInstanceGroupMemershipInstanceName:           *instanceGroupMembership.Instance.Name,
 			}
+			getInstanceOptions := &vpcv1.GetInstanceOptions{
+				ID: instanceGroupMembership.Instance.ID,
+			}
+			vsi, response, err := sess.GetInstance(getInstanceOptions)
+			if err != nil {
+				// Members that are being deleted may no longer have an instance.
+				if response == nil || response.StatusCode != 404 {
+					return fmt.Errorf("[ERROR] Error Getting Instance (%s) of InstanceGroup Membership %s\n%s", *instanceGroupMembership.Instance.ID, err, response)
+				}
+			} else if vsi.PrimaryNetworkInterface != nil && vsi.PrimaryNetworkInterface.PrimaryIP != nil && vsi.PrimaryNetworkInterface.PrimaryIP.Address != nil {
+				instance[isInstanceGroupMembershipInstancePrimaryIpv4] = *vsi.PrimaryNetworkInterface.PrimaryIP.Address
+			}
 			instances = append(instances, instance)
 		}
 		membership[isInstanceGroupMemershipInstance] = instances
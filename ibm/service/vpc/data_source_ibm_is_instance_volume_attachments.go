@@ -5,6 +5,7 @@ package vpc
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -133,12 +134,25 @@ func instanceGetVolumeAttachments(d *schema.ResourceData, meta interface{}, inst
 		return fmt.Errorf("[ERROR] Error Fetching Instance volume attachments %s\n%s", err, response)
 	}
 	allrecs = append(allrecs, volumeAtts.VolumeAttachments...)
+
+	// List the boot volume attachment first, then the data volume attachments
+	// by name, so that the order does not depend on the API.
+	sort.SliceStable(allrecs, func(i, j int) bool {
+		iBoot := *allrecs[i].Type == "boot"
+		jBoot := *allrecs[j].Type == "boot"
+		if iBoot != jBoot {
+			return iBoot
+		}
+		return *allrecs[i].Name < *allrecs[j].Name
+	})
 	volAttList := make([]map[string]interface{}, 0)
 	for _, volumeAtt := range allrecs {
 		currentVolAtt := map[string]interface{}{}
 		currentVolAtt[isInstanceVolAttName] = *volumeAtt.Name
 		currentVolAtt[isInstanceVolumeDeleteOnInstanceDelete] = *volumeAtt.DeleteVolumeOnInstanceDelete
-		currentVolAtt[isInstanceVolumeAttDevice] = *volumeAtt.Device.ID
+		if volumeAtt.Device != nil {
+			currentVolAtt[isInstanceVolumeAttDevice] = *volumeAtt.Device.ID
+		}
 		currentVolAtt[isInstanceVolumeAttHref] = *volumeAtt.Href
 		currentVolAtt[isInstanceVolAttId] = *volumeAtt.ID
 		currentVolAtt[isInstanceVolumeAttStatus] = *volumeAtt.Status
@@ -4,11 +4,13 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -19,7 +21,7 @@ const (
 
 func DataSourceIBMISInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISInstancesRead,
+		ReadContext: dataSourceIBMISInstancesRead,
 
 		Schema: map[string]*schema.Schema{
 			isInstanceGroup: {
@@ -553,16 +555,16 @@ func DataSourceIBMISInstances() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISInstancesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	err := instancesList(d, meta)
+	err := instancesList(context, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func instancesList(d *schema.ResourceData, meta interface{}) error {
+func instancesList(context context.Context, d *schema.ResourceData, meta interface{}) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -613,7 +615,7 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 			if start != "" {
 				listInstanceGroupOptions.Start = &start
 			}
-			instanceGroupsCollection, response, err := sess.ListInstanceGroups(&listInstanceGroupOptions)
+			instanceGroupsCollection, response, err := sess.ListInstanceGroupsWithContext(context, &listInstanceGroupOptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error Fetching InstanceGroups %s\n%s", err, response)
 			}
@@ -673,7 +675,7 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 			listInstancesOptions.Start = &start
 		}
 
-		instances, response, err := sess.ListInstances(listInstancesOptions)
+		instances, response, err := sess.ListInstancesWithContext(context, listInstancesOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Fetching Instances %s\n%s", err, response)
 		}
@@ -694,7 +696,7 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 			if start != "" {
 				listInstanceGroupMembershipsOptions.Start = &start
 			}
-			instanceGroupMembershipCollection, response, err := sess.ListInstanceGroupMemberships(&listInstanceGroupMembershipsOptions)
+			instanceGroupMembershipCollection, response, err := sess.ListInstanceGroupMembershipsWithContext(context, &listInstanceGroupMembershipsOptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership Collection %s\n%s", err, response)
 			}
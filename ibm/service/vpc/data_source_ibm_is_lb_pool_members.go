@@ -30,6 +30,26 @@ func DataSourceIBMISLBPoolMembers() *schema.Resource {
 				Required:    true,
 				Description: "The pool identifier.",
 			},
+			"healthy": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the pool has members and all of them are healthy (`ok`).",
+			},
+			"ok_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of members with `ok` health.",
+			},
+			"faulted_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of members with `faulted` health.",
+			},
+			"unknown_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of members with `unknown` health.",
+			},
 			"members": &schema.Schema{
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -152,6 +172,24 @@ func dataSourceIBMIsLbPoolMembersRead(context context.Context, d *schema.Resourc
 		}
 	}
 
+	okCount, faultedCount, unknownCount := 0, 0, 0
+	for _, member := range loadBalancerPoolMemberCollection.Members {
+		switch {
+		case member.Health == nil:
+			unknownCount++
+		case *member.Health == vpcv1.LoadBalancerPoolMemberHealthOkConst:
+			okCount++
+		case *member.Health == vpcv1.LoadBalancerPoolMemberHealthFaultedConst:
+			faultedCount++
+		default:
+			unknownCount++
+		}
+	}
+	d.Set("ok_count", okCount)
+	d.Set("faulted_count", faultedCount)
+	d.Set("unknown_count", unknownCount)
+	d.Set("healthy", okCount > 0 && faultedCount == 0 && unknownCount == 0)
+
 	return nil
 }
 
@@ -30,6 +30,8 @@ func TestAccIBMIsLbPoolMembersDataSourceBasic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.ibm_is_lb_pool_members.is_lb_pool_members", "lb"),
 					resource.TestCheckResourceAttrSet("data.ibm_is_lb_pool_members.is_lb_pool_members", "pool"),
 					resource.TestCheckResourceAttrSet("data.ibm_is_lb_pool_members.is_lb_pool_members", "members.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_lb_pool_members.is_lb_pool_members", "healthy"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_lb_pool_members.is_lb_pool_members", "ok_count"),
 				),
 			},
 		},
@@ -4,12 +4,14 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -19,7 +21,7 @@ const (
 
 func DataSourceIBMISPublicGateways() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISPublicGatewaysRead,
+		ReadContext: dataSourceIBMISPublicGatewaysRead,
 
 		Schema: map[string]*schema.Schema{
 			isPublicGateways: {
@@ -120,15 +122,15 @@ func DataSourceIBMISPublicGateways() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISPublicGatewaysRead(d *schema.ResourceData, meta interface{}) error {
-	err := publicGatewaysGet(d, meta, name)
+func dataSourceIBMISPublicGatewaysRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := publicGatewaysGet(context, d, meta, name)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func publicGatewaysGet(d *schema.ResourceData, meta interface{}, name string) error {
+func publicGatewaysGet(context context.Context, d *schema.ResourceData, meta interface{}, name string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -147,7 +149,7 @@ func publicGatewaysGet(d *schema.ResourceData, meta interface{}, name string) er
 		if rgroup != "" {
 			listPublicGatewaysOptions.ResourceGroupID = &rgroup
 		}
-		publicgws, response, err := sess.ListPublicGateways(listPublicGatewaysOptions)
+		publicgws, response, err := sess.ListPublicGatewaysWithContext(context, listPublicGatewaysOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Fetching public gateways %s\n%s", err, response)
 		}
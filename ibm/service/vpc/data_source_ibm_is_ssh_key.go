@@ -5,6 +5,7 @@ package vpc
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -23,9 +24,11 @@ func DataSourceIBMISSSHKey() *schema.Resource {
 			},
 
 			isKeyName: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of the ssh key",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{isKeyName, isKeyFingerprint},
+				Description:  "The name of the ssh key",
 			},
 
 			isKeyType: {
@@ -35,9 +38,11 @@ func DataSourceIBMISSSHKey() *schema.Resource {
 			},
 
 			isKeyFingerprint: {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The ssh key Fingerprint",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{isKeyName, isKeyFingerprint},
+				Description:  "The ssh key Fingerprint",
 			},
 
 			isKeyPublicKey: {
@@ -87,15 +92,18 @@ func DataSourceIBMISSSHKey() *schema.Resource {
 
 func dataSourceIBMISSSHKeyRead(d *schema.ResourceData, meta interface{}) error {
 	name := d.Get(isKeyName).(string)
+	fingerprint := d.Get(isKeyFingerprint).(string)
 
-	err := keyGetByName(d, meta, name)
+	err := keyGetByName(d, meta, name, fingerprint)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func keyGetByName(d *schema.ResourceData, meta interface{}, name string) error {
+// keyGetByName sets the key with the name, or else with the fingerprint, in
+// the resource group if set.
+func keyGetByName(d *schema.ResourceData, meta interface{}, name, fingerprint string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -120,8 +128,12 @@ func keyGetByName(d *schema.ResourceData, meta interface{}, name string) error {
 		}
 	}
 
+	resourceGroup := d.Get("resource_group").(string)
 	for _, key := range allrecs {
-		if *key.Name == name {
+		if resourceGroup != "" && (key.ResourceGroup == nil || *key.ResourceGroup.ID != resourceGroup) {
+			continue
+		}
+		if (name != "" && *key.Name == name) || (name == "" && keyFingerprintMatches(*key.Fingerprint, fingerprint)) {
 			d.SetId(*key.ID)
 			d.Set("name", *key.Name)
 			d.Set(isKeyType, *key.Type)
@@ -144,5 +156,15 @@ func keyGetByName(d *schema.ResourceData, meta interface{}, name string) error {
 			return nil
 		}
 	}
+	if name == "" {
+		return fmt.Errorf("[ERROR] No SSH Key found with fingerprint %s", fingerprint)
+	}
 	return fmt.Errorf("[ERROR] No SSH Key found with name %s", name)
 }
+
+// keyFingerprintMatches reports whether the key fingerprint, for example
+// SHA256:yxavE4CIOL2NlsqcurRO3xGjkP6m/0mp8ugojH5yxlY, matches the
+// fingerprint, with or without the SHA256: prefix.
+func keyFingerprintMatches(keyFingerprint, fingerprint string) bool {
+	return keyFingerprint == fingerprint || strings.TrimPrefix(keyFingerprint, "SHA256:") == strings.TrimPrefix(fingerprint, "SHA256:")
+}
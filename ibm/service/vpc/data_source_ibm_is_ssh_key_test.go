@@ -35,6 +35,27 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 	})
 }
 
+func TestAccIBMISSSHKeyDatasource_fingerprint(t *testing.T) {
+	name1 := fmt.Sprintf("tfssh-name-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testDSCheckIBMISSSHKeyFingerprintConfig(publicKey, name1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.ibm_is_ssh_key.ds_key", "name", name1),
+				),
+			},
+		},
+	})
+}
+
 func testDSCheckIBMISSSHKeyConfig(publicKey, name string) string {
 	return fmt.Sprintf(`
 		resource "ibm_is_ssh_key" "key" {
@@ -45,3 +66,14 @@ func testDSCheckIBMISSSHKeyConfig(publicKey, name string) string {
 		    name = "${ibm_is_ssh_key.key.name}"
 		}`, name, publicKey)
 }
+
+func testDSCheckIBMISSSHKeyFingerprintConfig(publicKey, name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_is_ssh_key" "key" {
+			name = "%s"
+			public_key = "%s"
+		}
+		data "ibm_is_ssh_key" "ds_key" {
+		    fingerprint = ibm_is_ssh_key.key.fingerprint
+		}`, name, publicKey)
+}
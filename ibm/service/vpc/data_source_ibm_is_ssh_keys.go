@@ -0,0 +1,194 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isKeys = "keys"
+)
+
+func DataSourceIBMIsSSHKeys() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsSSHKeysRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the keys of this resource group ID",
+			},
+			isKeyTags: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "Only list the keys that have all these tags",
+			},
+			isKeys: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Collection of ssh keys",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this key",
+						},
+						isKeyName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the ssh key",
+						},
+						IsKeyCRN: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The crn of the ssh key",
+						},
+						isKeyType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ssh key type",
+						},
+						isKeyFingerprint: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ssh key Fingerprint",
+						},
+						isKeyPublicKey: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "SSH Public key data",
+						},
+						isKeyLength: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ssh key length",
+						},
+						isKeyResourceGroup: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource group ID of the ssh key",
+						},
+						isKeyTags: {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         flex.ResourceIBMVPCHash,
+							Description: "List of tags for SSH key",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time that the key was created",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsSSHKeysRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listKeysOptions := &vpcv1.ListKeysOptions{}
+	start := ""
+	allrecs := []vpcv1.Key{}
+	for {
+		if start != "" {
+			listKeysOptions.Start = &start
+		}
+		keys, response, err := sess.ListKeysWithContext(context, listKeysOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListKeysWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error fetching Keys %s\n%s", err, response))
+		}
+		start = flex.GetNext(keys.Next)
+		allrecs = append(allrecs, keys.Keys...)
+		if start == "" {
+			break
+		}
+	}
+
+	resourceGroup := d.Get("resource_group").(string)
+	filterTags := flex.ExpandStringList(d.Get(isKeyTags).(*schema.Set).List())
+	keys := make([]map[string]interface{}, 0, len(allrecs))
+	for _, key := range allrecs {
+		if resourceGroup != "" && (key.ResourceGroup == nil || *key.ResourceGroup.ID != resourceGroup) {
+			continue
+		}
+		k := map[string]interface{}{
+			"id":             *key.ID,
+			isKeyName:        *key.Name,
+			IsKeyCRN:         *key.CRN,
+			isKeyType:        *key.Type,
+			isKeyFingerprint: *key.Fingerprint,
+			isKeyLength:      *key.Length,
+		}
+		// The tags take a call to the global tagging API per key, they are only
+		// looked up to filter the keys
+		if len(filterTags) > 0 {
+			tags, err := flex.GetTagsUsingCRN(meta, *key.CRN)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error on get of vpc SSH Key (%s) tags: %s", *key.ID, err))
+			}
+			if !keyHasAllTags(tags.List(), filterTags) {
+				continue
+			}
+			k[isKeyTags] = tags
+		}
+		if key.PublicKey != nil {
+			k[isKeyPublicKey] = *key.PublicKey
+		}
+		if key.ResourceGroup != nil {
+			k[isKeyResourceGroup] = *key.ResourceGroup.ID
+		}
+		if key.CreatedAt != nil {
+			k["created_at"] = key.CreatedAt.String()
+		}
+		keys = append(keys, k)
+	}
+
+	d.SetId(dataSourceIBMIsSSHKeysID(d))
+	if err = d.Set(isKeys, keys); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting keys %s", err))
+	}
+	return nil
+}
+
+// dataSourceIBMIsSSHKeysID returns a reasonable ID for the list.
+func dataSourceIBMIsSSHKeysID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
+
+func keyHasAllTags(tags []interface{}, filterTags []string) bool {
+	for _, filterTag := range filterTags {
+		found := false
+		for _, tag := range tags {
+			if tag.(string) == filterTag {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -4,12 +4,14 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -21,7 +23,7 @@ const (
 
 func DataSourceIBMISSubnets() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISSubnetsRead,
+		ReadContext: dataSourceIBMISSubnetsRead,
 
 		Schema: map[string]*schema.Schema{
 			isSubnetResourceGroupID: {
@@ -103,15 +105,15 @@ func DataSourceIBMISSubnets() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISSubnetsRead(d *schema.ResourceData, meta interface{}) error {
-	err := subnetList(d, meta)
+func dataSourceIBMISSubnetsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := subnetList(context, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func subnetList(d *schema.ResourceData, meta interface{}) error {
+func subnetList(context context.Context, d *schema.ResourceData, meta interface{}) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -149,7 +151,7 @@ func subnetList(d *schema.ResourceData, meta interface{}) error {
 		if start != "" {
 			options.Start = &start
 		}
-		subnets, response, err := sess.ListSubnets(options)
+		subnets, response, err := sess.ListSubnetsWithContext(context, options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Fetching subnets %s\n%s", err, response)
 		}
@@ -14,6 +14,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+const (
+	isVPCIncludeAttachedResources = "include_attached_resources"
+	isVPCSubnetCount              = "subnet_count"
+	isVPCInstances                = "instances"
+	isVPCInstanceCount            = "instance_count"
+	isVPCPublicGateways           = "public_gateways"
+	isVPCPublicGatewayCount       = "public_gateway_count"
+	isVPCEndpointGateways         = "endpoint_gateways"
+	isVPCEndpointGatewayCount     = "endpoint_gateway_count"
+	isVPCRoutingTables            = "routing_tables"
+	isVPCRoutingTableCount        = "routing_table_count"
+)
+
 func DataSourceIBMISVPC() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceIBMISVPCRead,
@@ -271,6 +284,89 @@ func DataSourceIBMISVPC() *schema.Resource {
 					},
 				},
 			},
+
+			isVPCIncludeAttachedResources: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "List the instances, public gateways, endpoint gateways and routing tables of the VPC",
+			},
+
+			isVPCSubnetCount: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of subnets in the VPC",
+			},
+
+			isVPCInstances: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The instances in the VPC, set when include_attached_resources is true",
+				Elem:        dataSourceIBMISVPCAttachedResource("instance"),
+			},
+
+			isVPCInstanceCount: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of instances in the VPC, set when include_attached_resources is true",
+			},
+
+			isVPCPublicGateways: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The public gateways in the VPC, set when include_attached_resources is true",
+				Elem:        dataSourceIBMISVPCAttachedResource("public gateway"),
+			},
+
+			isVPCPublicGatewayCount: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of public gateways in the VPC, set when include_attached_resources is true",
+			},
+
+			isVPCEndpointGateways: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The endpoint gateways in the VPC, set when include_attached_resources is true",
+				Elem:        dataSourceIBMISVPCAttachedResource("endpoint gateway"),
+			},
+
+			isVPCEndpointGatewayCount: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of endpoint gateways in the VPC, set when include_attached_resources is true",
+			},
+
+			isVPCRoutingTables: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The routing tables of the VPC, set when include_attached_resources is true",
+				Elem:        dataSourceIBMISVPCAttachedResource("routing table"),
+			},
+
+			isVPCRoutingTableCount: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of routing tables of the VPC, set when include_attached_resources is true",
+			},
+		},
+	}
+}
+
+func dataSourceIBMISVPCAttachedResource(kind string) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: kind + " ID",
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: kind + " name",
+			},
 		},
 	}
 }
@@ -414,6 +510,14 @@ func vpcGetByName(d *schema.ResourceData, meta interface{}, name string) error {
 					}
 				}
 				d.Set(subnetsList, subnetsInfo)
+				d.Set(isVPCSubnetCount, len(subnetsInfo))
+			}
+
+			if d.Get(isVPCIncludeAttachedResources).(bool) {
+				err = vpcSetAttachedResources(d, sess, d.Id())
+				if err != nil {
+					return err
+				}
 			}
 
 			// adding pagination support for sg inside vpc
@@ -556,3 +660,118 @@ func vpcGetByName(d *schema.ResourceData, meta interface{}, name string) error {
 	}
 	return fmt.Errorf("[ERROR] No VPC found with name %s", name)
 }
+
+// vpcSetAttachedResources sets the instances, public gateways, endpoint
+// gateways and routing tables in the VPC, and their counts. The instances and
+// routing tables are listed for the VPC only, the public and endpoint
+// gateways are listed for the region and filtered as the SDK has no VPC
+// filter for them.
+func vpcSetAttachedResources(d *schema.ResourceData, sess *vpcv1.VpcV1, vpcID string) error {
+	instances := make([]map[string]interface{}, 0)
+	listInstancesOptions := &vpcv1.ListInstancesOptions{
+		VPCID: &vpcID,
+	}
+	start := ""
+	for {
+		if start != "" {
+			listInstancesOptions.Start = &start
+		}
+		collection, response, err := sess.ListInstances(listInstancesOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error fetching instances %s\n%s", err, response)
+		}
+		for _, instance := range collection.Instances {
+			instances = append(instances, map[string]interface{}{
+				"id":   *instance.ID,
+				"name": *instance.Name,
+			})
+		}
+		start = flex.GetNext(collection.Next)
+		if start == "" {
+			break
+		}
+	}
+	d.Set(isVPCInstances, instances)
+	d.Set(isVPCInstanceCount, len(instances))
+
+	publicGateways := make([]map[string]interface{}, 0)
+	listPublicGatewaysOptions := &vpcv1.ListPublicGatewaysOptions{}
+	start = ""
+	for {
+		if start != "" {
+			listPublicGatewaysOptions.Start = &start
+		}
+		collection, response, err := sess.ListPublicGateways(listPublicGatewaysOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error fetching public gateways %s\n%s", err, response)
+		}
+		for _, publicGateway := range collection.PublicGateways {
+			if publicGateway.VPC != nil && *publicGateway.VPC.ID == vpcID {
+				publicGateways = append(publicGateways, map[string]interface{}{
+					"id":   *publicGateway.ID,
+					"name": *publicGateway.Name,
+				})
+			}
+		}
+		start = flex.GetNext(collection.Next)
+		if start == "" {
+			break
+		}
+	}
+	d.Set(isVPCPublicGateways, publicGateways)
+	d.Set(isVPCPublicGatewayCount, len(publicGateways))
+
+	endpointGateways := make([]map[string]interface{}, 0)
+	listEndpointGatewaysOptions := &vpcv1.ListEndpointGatewaysOptions{}
+	start = ""
+	for {
+		if start != "" {
+			listEndpointGatewaysOptions.Start = &start
+		}
+		collection, response, err := sess.ListEndpointGateways(listEndpointGatewaysOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error fetching endpoint gateways %s\n%s", err, response)
+		}
+		for _, endpointGateway := range collection.EndpointGateways {
+			if endpointGateway.VPC != nil && *endpointGateway.VPC.ID == vpcID {
+				endpointGateways = append(endpointGateways, map[string]interface{}{
+					"id":   *endpointGateway.ID,
+					"name": *endpointGateway.Name,
+				})
+			}
+		}
+		start = flex.GetNext(collection.Next)
+		if start == "" {
+			break
+		}
+	}
+	d.Set(isVPCEndpointGateways, endpointGateways)
+	d.Set(isVPCEndpointGatewayCount, len(endpointGateways))
+
+	routingTables := make([]map[string]interface{}, 0)
+	listVPCRoutingTablesOptions := sess.NewListVPCRoutingTablesOptions(vpcID)
+	start = ""
+	for {
+		if start != "" {
+			listVPCRoutingTablesOptions.Start = &start
+		}
+		collection, response, err := sess.ListVPCRoutingTables(listVPCRoutingTablesOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error fetching routing tables %s\n%s", err, response)
+		}
+		for _, routingTable := range collection.RoutingTables {
+			routingTables = append(routingTables, map[string]interface{}{
+				"id":   *routingTable.ID,
+				"name": *routingTable.Name,
+			})
+		}
+		start = flex.GetNext(collection.Next)
+		if start == "" {
+			break
+		}
+	}
+	d.Set(isVPCRoutingTables, routingTables)
+	d.Set(isVPCRoutingTableCount, len(routingTables))
+
+	return nil
+}
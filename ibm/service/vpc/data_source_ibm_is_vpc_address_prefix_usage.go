@@ -0,0 +1,223 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"net"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func DataSourceIBMIsVPCAddressPrefixUsage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsVPCAddressPrefixUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The VPC identifier.",
+			},
+			"address_prefixes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The utilization of each address prefix of the VPC by its subnets.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this address prefix.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The user-defined name for this address prefix.",
+						},
+						"cidr": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CIDR block for this prefix.",
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the zone this address prefix resides in.",
+						},
+						"total_address_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of addresses in the address prefix.",
+						},
+						"subnet_address_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of addresses of the address prefix that are allocated to subnets.",
+						},
+						"free_address_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of addresses of the address prefix that are not allocated to any subnet.",
+						},
+						"utilization_percentage": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The percentage of the addresses of the address prefix that are allocated to subnets.",
+						},
+						"subnets": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The subnets whose CIDR block is within the address prefix.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The unique identifier for this subnet.",
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The user-defined name for this subnet.",
+									},
+									"ipv4_cidr_block": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The IPv4 range of the subnet, expressed in CIDR format.",
+									},
+									"total_ipv4_address_count": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The total number of IPv4 addresses in this subnet.",
+									},
+									"available_ipv4_address_count": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The number of IPv4 addresses in this subnet that are not in-use, and have not been reserved by the user or the provider.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsVPCAddressPrefixUsageRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	vpcID := d.Get("vpc").(string)
+
+	start := ""
+	allPrefixes := []vpcv1.AddressPrefix{}
+	for {
+		listVpcAddressPrefixesOptions := &vpcv1.ListVPCAddressPrefixesOptions{}
+		listVpcAddressPrefixesOptions.SetVPCID(vpcID)
+		if start != "" {
+			listVpcAddressPrefixesOptions.Start = &start
+		}
+		addressPrefixCollection, response, err := vpcClient.ListVPCAddressPrefixesWithContext(context, listVpcAddressPrefixesOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListVpcAddressPrefixesWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("ListVpcAddressPrefixesWithContext failed %s\n%s", err, response))
+		}
+		start = flex.GetNext(addressPrefixCollection.Next)
+		allPrefixes = append(allPrefixes, addressPrefixCollection.AddressPrefixes...)
+		if start == "" {
+			break
+		}
+	}
+
+	start = ""
+	vpcSubnets := []vpcv1.Subnet{}
+	for {
+		listSubnetsOptions := &vpcv1.ListSubnetsOptions{}
+		if start != "" {
+			listSubnetsOptions.Start = &start
+		}
+		subnetCollection, response, err := vpcClient.ListSubnetsWithContext(context, listSubnetsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListSubnetsWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("ListSubnetsWithContext failed %s\n%s", err, response))
+		}
+		start = flex.GetNext(subnetCollection.Next)
+		for _, subnet := range subnetCollection.Subnets {
+			if subnet.VPC != nil && subnet.VPC.ID != nil && *subnet.VPC.ID == vpcID {
+				vpcSubnets = append(vpcSubnets, subnet)
+			}
+		}
+		if start == "" {
+			break
+		}
+	}
+
+	addressPrefixes := make([]map[string]interface{}, 0, len(allPrefixes))
+	for _, prefix := range allPrefixes {
+		_, prefixNet, err := net.ParseCIDR(*prefix.CIDR)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error parsing CIDR %s of address prefix %s: %s", *prefix.CIDR, *prefix.ID, err))
+		}
+		ones, bits := prefixNet.Mask.Size()
+		totalCount := int64(math.Pow(2, float64(bits-ones)))
+
+		var subnetCount int64
+		subnets := make([]map[string]interface{}, 0)
+		for _, subnet := range vpcSubnets {
+			if subnet.Ipv4CIDRBlock == nil {
+				continue
+			}
+			subnetIP, _, err := net.ParseCIDR(*subnet.Ipv4CIDRBlock)
+			if err != nil || !prefixNet.Contains(subnetIP) {
+				continue
+			}
+			subnetMap := map[string]interface{}{
+				"id":              *subnet.ID,
+				"name":            *subnet.Name,
+				"ipv4_cidr_block": *subnet.Ipv4CIDRBlock,
+			}
+			if subnet.TotalIpv4AddressCount != nil {
+				subnetMap["total_ipv4_address_count"] = *subnet.TotalIpv4AddressCount
+				subnetCount += *subnet.TotalIpv4AddressCount
+			}
+			if subnet.AvailableIpv4AddressCount != nil {
+				subnetMap["available_ipv4_address_count"] = *subnet.AvailableIpv4AddressCount
+			}
+			subnets = append(subnets, subnetMap)
+		}
+
+		prefixMap := map[string]interface{}{
+			"id":                     *prefix.ID,
+			"name":                   *prefix.Name,
+			"cidr":                   *prefix.CIDR,
+			"total_address_count":    totalCount,
+			"subnet_address_count":   subnetCount,
+			"free_address_count":     totalCount - subnetCount,
+			"utilization_percentage": float64(subnetCount) * 100 / float64(totalCount),
+			"subnets":                subnets,
+		}
+		if prefix.Zone != nil && prefix.Zone.Name != nil {
+			prefixMap["zone"] = *prefix.Zone.Name
+		}
+		addressPrefixes = append(addressPrefixes, prefixMap)
+	}
+
+	d.SetId(vpcID)
+	if err = d.Set("address_prefixes", addressPrefixes); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting address_prefixes %s", err))
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIsVPCAddressPrefixUsageDataSourceBasic(t *testing.T) {
+	name := fmt.Sprintf("tfvpcuat-%d", acctest.RandIntRange(10, 100))
+	prefixName := fmt.Sprintf("tfaddprename-%d", acctest.RandIntRange(10, 100))
+	subnetName := fmt.Sprintf("tfsubnet-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsVPCAddressPrefixUsageDataSourceConfigBasic(name, prefixName, subnetName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_vpc_address_prefix_usage.usage", "address_prefixes.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpc_address_prefix_usage.usage", "address_prefixes.0.name", prefixName),
+					resource.TestCheckResourceAttr("data.ibm_is_vpc_address_prefix_usage.usage", "address_prefixes.0.subnets.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpc_address_prefix_usage.usage", "address_prefixes.0.subnets.0.name", subnetName),
+					resource.TestCheckResourceAttrSet("data.ibm_is_vpc_address_prefix_usage.usage", "address_prefixes.0.utilization_percentage"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsVPCAddressPrefixUsageDataSourceConfigBasic(name, prefixName, subnetName string) string {
+	return testAccCheckIBMISVPCAddressPrefixConfig(name, prefixName) + fmt.Sprintf(`
+		resource "ibm_is_subnet" "testacc_subnet" {
+			name            = "%s"
+			vpc             = ibm_is_vpc.testacc_vpc.id
+			zone            = ibm_is_vpc_address_prefix.testacc_vpc_address_prefix.zone
+			ipv4_cidr_block = cidrsubnet(ibm_is_vpc_address_prefix.testacc_vpc_address_prefix.cidr, 2, 0)
+		}
+
+		data "ibm_is_vpc_address_prefix_usage" "usage" {
+			vpc        = ibm_is_vpc.testacc_vpc.id
+			depends_on = [ibm_is_subnet.testacc_subnet]
+		}
+	`, subnetName)
+}
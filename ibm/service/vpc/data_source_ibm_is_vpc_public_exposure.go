@@ -0,0 +1,269 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// DataSourceIBMIsVPCPublicExposure reports the resources of a VPC that connect
+// it to the public internet, so that a private only landing zone can be
+// asserted, for example with a precondition or in a CI check.
+func DataSourceIBMIsVPCPublicExposure() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsVPCPublicExposureRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The VPC identifier.",
+			},
+			"public": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether any resource of the VPC is exposed to the public internet.",
+			},
+			"exposures": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The resources that expose the VPC to the public internet.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the resource: subnet, public_gateway, floating_ip or load_balancer.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the resource.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The user-defined name of the resource.",
+						},
+						"direction": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The direction of the public traffic the resource allows: egress, ingress or ingress_egress.",
+						},
+						"reason": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Why the resource is a public exposure.",
+						},
+					},
+				},
+			},
+			"endpoint_gateways": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The identifiers of the endpoint gateways of the VPC, which connect to services privately and are not exposures.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsVPCPublicExposureRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	vpcID := d.Get("vpc").(string)
+	exposures := make([]map[string]interface{}, 0)
+	addExposure := func(resourceType, id, name, direction, reason string) {
+		exposures = append(exposures, map[string]interface{}{
+			"resource_type": resourceType,
+			"id":            id,
+			"name":          name,
+			"direction":     direction,
+			"reason":        reason,
+		})
+	}
+
+	// Subnets that egress through a public gateway
+	start := ""
+	vpcSubnetIDs := map[string]bool{}
+	for {
+		listSubnetsOptions := &vpcv1.ListSubnetsOptions{}
+		if start != "" {
+			listSubnetsOptions.Start = &start
+		}
+		subnetCollection, response, err := vpcClient.ListSubnetsWithContext(context, listSubnetsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListSubnetsWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("ListSubnetsWithContext failed %s\n%s", err, response))
+		}
+		start = flex.GetNext(subnetCollection.Next)
+		for _, subnet := range subnetCollection.Subnets {
+			if subnet.VPC == nil || subnet.VPC.ID == nil || *subnet.VPC.ID != vpcID {
+				continue
+			}
+			vpcSubnetIDs[*subnet.ID] = true
+			if subnet.PublicGateway != nil && subnet.PublicGateway.ID != nil {
+				addExposure("subnet", *subnet.ID, *subnet.Name, "egress", fmt.Sprintf("The subnet is attached to the public gateway %s", *subnet.PublicGateway.ID))
+			}
+		}
+		if start == "" {
+			break
+		}
+	}
+
+	// Public gateways of the VPC, even if no subnet is attached yet
+	start = ""
+	for {
+		listPublicGatewaysOptions := &vpcv1.ListPublicGatewaysOptions{}
+		if start != "" {
+			listPublicGatewaysOptions.Start = &start
+		}
+		publicgws, response, err := vpcClient.ListPublicGatewaysWithContext(context, listPublicGatewaysOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListPublicGatewaysWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("ListPublicGatewaysWithContext failed %s\n%s", err, response))
+		}
+		start = flex.GetNext(publicgws.Next)
+		for _, publicgw := range publicgws.PublicGateways {
+			if publicgw.VPC == nil || *publicgw.VPC.ID != vpcID {
+				continue
+			}
+			reason := fmt.Sprintf("The public gateway provides outbound internet access in zone %s", *publicgw.Zone.Name)
+			if publicgw.FloatingIP != nil && publicgw.FloatingIP.Address != nil {
+				reason = fmt.Sprintf("%s through %s", reason, *publicgw.FloatingIP.Address)
+			}
+			addExposure("public_gateway", *publicgw.ID, *publicgw.Name, "egress", reason)
+		}
+		if start == "" {
+			break
+		}
+	}
+
+	// Network interfaces of the instances of the VPC, to find their floating IPs
+	start = ""
+	vpcNetworkInterfaces := map[string]string{}
+	for {
+		listInstancesOptions := &vpcv1.ListInstancesOptions{}
+		listInstancesOptions.SetVPCID(vpcID)
+		if start != "" {
+			listInstancesOptions.Start = &start
+		}
+		instances, response, err := vpcClient.ListInstancesWithContext(context, listInstancesOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListInstancesWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("ListInstancesWithContext failed %s\n%s", err, response))
+		}
+		start = flex.GetNext(instances.Next)
+		for _, instance := range instances.Instances {
+			if instance.PrimaryNetworkInterface != nil {
+				vpcNetworkInterfaces[*instance.PrimaryNetworkInterface.ID] = *instance.Name
+			}
+			for _, nic := range instance.NetworkInterfaces {
+				vpcNetworkInterfaces[*nic.ID] = *instance.Name
+			}
+		}
+		if start == "" {
+			break
+		}
+	}
+
+	start = ""
+	for {
+		listFloatingIpsOptions := &vpcv1.ListFloatingIpsOptions{}
+		if start != "" {
+			listFloatingIpsOptions.Start = &start
+		}
+		floatingIPs, response, err := vpcClient.ListFloatingIpsWithContext(context, listFloatingIpsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListFloatingIpsWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("ListFloatingIpsWithContext failed %s\n%s", err, response))
+		}
+		start = flex.GetNext(floatingIPs.Next)
+		for _, fip := range floatingIPs.FloatingIps {
+			target, ok := fip.Target.(*vpcv1.FloatingIPTarget)
+			if !ok || target.ID == nil {
+				continue
+			}
+			if instanceName, ok := vpcNetworkInterfaces[*target.ID]; ok {
+				addExposure("floating_ip", *fip.ID, *fip.Name, "ingress_egress", fmt.Sprintf("The floating IP %s is bound to a network interface of the instance %s", *fip.Address, instanceName))
+			}
+		}
+		if start == "" {
+			break
+		}
+	}
+
+	// Public load balancers in the subnets of the VPC
+	start = ""
+	for {
+		listLoadBalancersOptions := &vpcv1.ListLoadBalancersOptions{}
+		if start != "" {
+			listLoadBalancersOptions.Start = &start
+		}
+		loadBalancers, response, err := vpcClient.ListLoadBalancersWithContext(context, listLoadBalancersOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListLoadBalancersWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("ListLoadBalancersWithContext failed %s\n%s", err, response))
+		}
+		start = flex.GetNext(loadBalancers.Next)
+		for _, lb := range loadBalancers.LoadBalancers {
+			if lb.IsPublic == nil || !*lb.IsPublic {
+				continue
+			}
+			for _, subnet := range lb.Subnets {
+				if vpcSubnetIDs[*subnet.ID] {
+					addExposure("load_balancer", *lb.ID, *lb.Name, "ingress", fmt.Sprintf("The load balancer is public with hostname %s", *lb.Hostname))
+					break
+				}
+			}
+		}
+		if start == "" {
+			break
+		}
+	}
+
+	start = ""
+	endpointGateways := []string{}
+	for {
+		listEndpointGatewaysOptions := &vpcv1.ListEndpointGatewaysOptions{}
+		if start != "" {
+			listEndpointGatewaysOptions.Start = &start
+		}
+		endpointGatewayCollection, response, err := vpcClient.ListEndpointGatewaysWithContext(context, listEndpointGatewaysOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListEndpointGatewaysWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("ListEndpointGatewaysWithContext failed %s\n%s", err, response))
+		}
+		start = flex.GetNext(endpointGatewayCollection.Next)
+		for _, endpointGateway := range endpointGatewayCollection.EndpointGateways {
+			if endpointGateway.VPC != nil && *endpointGateway.VPC.ID == vpcID {
+				endpointGateways = append(endpointGateways, *endpointGateway.ID)
+			}
+		}
+		if start == "" {
+			break
+		}
+	}
+
+	d.SetId(vpcID)
+	d.Set("public", len(exposures) > 0)
+	if err = d.Set("exposures", exposures); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting exposures %s", err))
+	}
+	if err = d.Set("endpoint_gateways", endpointGateways); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting endpoint_gateways %s", err))
+	}
+	return nil
+}
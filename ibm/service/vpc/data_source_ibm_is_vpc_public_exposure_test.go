@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIsVPCPublicExposureDataSourceBasic(t *testing.T) {
+	vpcname := fmt.Sprintf("tfvpcexp-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tfvpcexp-subnet-%d", acctest.RandIntRange(10, 100))
+	gatewayname := fmt.Sprintf("tfvpcexp-pgw-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsVPCPublicExposureDataSourceConfig(vpcname, subnetname, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_vpc_public_exposure.exposure", "public", "false"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpc_public_exposure.exposure", "exposures.#", "0"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIsVPCPublicExposureDataSourceConfig(vpcname, subnetname, gatewayname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_vpc_public_exposure.exposure", "public", "true"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpc_public_exposure.exposure", "exposures.#", "2"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpc_public_exposure.exposure", "exposures.0.resource_type", "subnet"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpc_public_exposure.exposure", "exposures.1.resource_type", "public_gateway"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsVPCPublicExposureDataSourceConfig(vpcname, subnetname, gatewayname string) string {
+	publicGateway := "null"
+	if gatewayname != "" {
+		publicGateway = "ibm_is_public_gateway.testacc_public_gateway[0].id"
+	}
+	return fmt.Sprintf(`
+		resource "ibm_is_vpc" "testacc_vpc" {
+			name = "%s"
+		}
+
+		resource "ibm_is_public_gateway" "testacc_public_gateway" {
+			count = %t ? 1 : 0
+			name  = "%s"
+			vpc   = ibm_is_vpc.testacc_vpc.id
+			zone  = "%s"
+		}
+
+		resource "ibm_is_subnet" "testacc_subnet" {
+			name                     = "%s"
+			vpc                      = ibm_is_vpc.testacc_vpc.id
+			zone                     = "%s"
+			total_ipv4_address_count = 16
+			public_gateway           = %s
+		}
+
+		data "ibm_is_vpc_public_exposure" "exposure" {
+			vpc        = ibm_is_vpc.testacc_vpc.id
+			depends_on = [ibm_is_subnet.testacc_subnet]
+		}
+	`, vpcname, gatewayname != "", gatewayname, acc.ISZoneName, subnetname, acc.ISZoneName, publicGateway)
+}
@@ -34,6 +34,32 @@ func TestAccIBMISVPCDatasource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.ibm_is_vpc.ds_vpc", "default_network_acl_name"),
 					resource.TestCheckResourceAttrSet("data.ibm_is_vpc.ds_vpc", "default_security_group_name"),
 					resource.TestCheckResourceAttrSet("data.ibm_is_vpc.ds_vpc", "default_routing_table_name"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpc.ds_vpc", "subnet_count", "0"),
+					resource.TestCheckNoResourceAttr("data.ibm_is_vpc.ds_vpc", "routing_table_count"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMISVPCDatasource_attachedResources(t *testing.T) {
+	var vpc string
+	name := fmt.Sprintf("tfc-vpc-name-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPCDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testDSCheckIBMISVPCAttachedResourcesConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPCExists("ibm_is_vpc.testacc_vpc", vpc),
+					resource.TestCheckResourceAttr("data.ibm_is_vpc.ds_vpc", "instance_count", "0"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpc.ds_vpc", "public_gateway_count", "0"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpc.ds_vpc", "endpoint_gateway_count", "0"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpc.ds_vpc", "routing_table_count", "1"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpc.ds_vpc", "routing_tables.#", "1"),
 				),
 			},
 		},
@@ -75,6 +101,17 @@ func testDSCheckIBMISVPCConfig(name string) string {
 		}`, name)
 }
 
+func testDSCheckIBMISVPCAttachedResourcesConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_is_vpc" "testacc_vpc" {
+			name = "%s"
+		}
+		data "ibm_is_vpc" "ds_vpc" {
+			name                       = ibm_is_vpc.testacc_vpc.name
+			include_attached_resources = true
+		}`, name)
+}
+
 func testDSCheckIBMISVPCSgConfig(vpcname, sgname string) string {
 	return fmt.Sprintf(`
 	resource "ibm_is_vpc" "testacc_vpc" {
@@ -7,8 +7,8 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -89,7 +89,7 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 		),
@@ -329,7 +329,7 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 			isBareMetalServerNetworkInterfaces: {
 				Type:             schema.TypeList,
 				Optional:         true,
-				DiffSuppressFunc: flex.ApplyOnce,
+				DiffSuppressFunc: bareMetalServerNicsApplyOnce,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -580,6 +580,25 @@ func ResourceIBMIsBareMetalServerValidator() *validate.ResourceValidator {
 	return &ibmISBareMetalServerResourceValidator
 }
 
+// bareMetalServerNicsApplyOnce suppresses the changes of network_interfaces
+// once the server exists, except for the settings of existing interfaces
+// that are updated in place.
+func bareMetalServerNicsApplyOnce(k, o, n string, d *schema.ResourceData) bool {
+	if len(d.Id()) == 0 {
+		return false
+	}
+	parts := strings.Split(k, ".")
+	if len(parts) < 3 {
+		return true
+	}
+	switch parts[2] {
+	case isBareMetalServerNicAllowedVlans, isBareMetalServerNicAllowIPSpoofing, isBareMetalServerNicName:
+		oldId, _ := d.GetChange(fmt.Sprintf("%s.%s.id", parts[0], parts[1]))
+		return oldId.(string) == ""
+	}
+	return true
+}
+
 func resourceIBMISBareMetalServerCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := vpcClient(meta)
@@ -922,8 +941,8 @@ func resourceIBMISBareMetalServerCreate(context context.Context, d *schema.Resou
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isBareMetalServerTags); ok || v != "" {
+	v := flex.EnvTags(meta)
+	if _, ok := d.GetOk(isBareMetalServerTags); ok || len(v) > 0 {
 		oldList, newList := d.GetChange(isBareMetalServerTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *bms.CRN)
 		if err != nil {
@@ -1147,6 +1166,13 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 					currentNic[isBareMetalServerNicSubnet] = *bmsnic.Subnet.ID
 					currentNic[isBareMetalServerNicPortSpeed] = *bmsnic.PortSpeed
 					currentNic[isBareMetalServerNicInterfaceType] = "pci"
+					if bmsnic.AllowedVlans != nil {
+						var out = make([]interface{}, len(bmsnic.AllowedVlans), len(bmsnic.AllowedVlans))
+						for i, v := range bmsnic.AllowedVlans {
+							out[i] = int(v)
+						}
+						currentNic[isBareMetalServerNicAllowedVlans] = schema.NewSet(schema.HashInt, out)
+					}
 					if len(bmsnic.SecurityGroups) != 0 {
 						secgrpList := []string{}
 						for i := 0; i < len(bmsnic.SecurityGroups); i++ {
@@ -1292,14 +1318,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		}
 		bmsNicPatchModel := &vpcv1.BareMetalServerNetworkInterfacePatch{}
 		if d.HasChange("primary_network_interface.0.allowed_vlans") {
-			if allowedVlansOk, ok := d.GetOk("primary_network_interface.0.allowed_vlans"); ok {
-				allowedVlansList := allowedVlansOk.(*schema.Set).List()
-				allowedVlans := make([]int64, 0, len(allowedVlansList))
-				for _, k := range allowedVlansList {
-					allowedVlans = append(allowedVlans, int64(k.(int)))
-				}
-				bmsNicPatchModel.AllowedVlans = allowedVlans
-			}
+			bmsNicPatchModel.AllowedVlans = expandBareMetalServerNicAllowedVlans(d.Get("primary_network_interface.0.allowed_vlans").(*schema.Set))
 		}
 		if d.HasChange("primary_network_interface.0.allow_ip_spoofing") {
 
@@ -1322,7 +1341,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 				bmsNicPatchModel.Name = &name
 			}
 		}
-		bmsNicPatch, err := bmsNicPatchModel.AsPatch()
+		bmsNicPatch, err := bareMetalServerNicPatchAsPatch(bmsNicPatchModel, d.HasChange("primary_network_interface.0.allowed_vlans"))
 		if err != nil {
 			return err
 		}
@@ -1336,6 +1355,53 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 			return err
 		}
 	}
+	if d.HasChange(isBareMetalServerNetworkInterfaces) {
+		// Interfaces can't be added or removed through this list once the server
+		// exists (use ibm_is_bare_metal_server_network_interface for that), but
+		// the settings of the existing interfaces are updated in place.
+		nics := d.Get(isBareMetalServerNetworkInterfaces).([]interface{})
+		for i := range nics {
+			nicKey := fmt.Sprintf("%s.%d", isBareMetalServerNetworkInterfaces, i)
+			nicId := d.Get(nicKey + ".id").(string)
+			if nicId == "" {
+				continue
+			}
+			nicFlag := false
+			bmsNicPatchModel := &vpcv1.BareMetalServerNetworkInterfacePatch{}
+			allowedVlansKey := nicKey + "." + isBareMetalServerNicAllowedVlans
+			if d.HasChange(allowedVlansKey) {
+				nicFlag = true
+				bmsNicPatchModel.AllowedVlans = expandBareMetalServerNicAllowedVlans(d.Get(allowedVlansKey).(*schema.Set))
+			}
+			if d.HasChange(nicKey + "." + isBareMetalServerNicAllowIPSpoofing) {
+				nicFlag = true
+				allowIpSpoofing := d.Get(nicKey + "." + isBareMetalServerNicAllowIPSpoofing).(bool)
+				bmsNicPatchModel.AllowIPSpoofing = &allowIpSpoofing
+			}
+			if d.HasChange(nicKey + "." + isBareMetalServerNicName) {
+				if name := d.Get(nicKey + "." + isBareMetalServerNicName).(string); name != "" {
+					nicFlag = true
+					bmsNicPatchModel.Name = &name
+				}
+			}
+			if !nicFlag {
+				continue
+			}
+			bmsNicPatch, err := bareMetalServerNicPatchAsPatch(bmsNicPatchModel, d.HasChange(allowedVlansKey))
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error calling asPatch for BareMetalServerNetworkInterfacePatch: %s", err)
+			}
+			bmsNicUpdateOptions := &vpcv1.UpdateBareMetalServerNetworkInterfaceOptions{
+				BareMetalServerID:                    &id,
+				ID:                                   &nicId,
+				BareMetalServerNetworkInterfacePatch: bmsNicPatch,
+			}
+			_, response, err := sess.UpdateBareMetalServerNetworkInterfaceWithContext(context, bmsNicUpdateOptions)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error updating bare metal server network interface (%s): %s\n%s", nicId, err, response)
+			}
+		}
+	}
 	if d.HasChange(isBareMetalServerName) {
 		flag = true
 		nameStr := ""
@@ -774,15 +774,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceUpdate(context context.Context,
 	}
 	if d.HasChange(isBareMetalServerNicAllowedVlans) {
 		flag = true
-
-		if allowedVlansOk, ok := d.GetOk(isBareMetalServerNicAllowedVlans); ok {
-			allowedVlansList := allowedVlansOk.(*schema.Set).List()
-			allowedVlans := make([]int64, 0, len(allowedVlansList))
-			for _, k := range allowedVlansList {
-				allowedVlans = append(allowedVlans, int64(k.(int)))
-			}
-			nicPatchModel.AllowedVlans = allowedVlans
-		}
+		nicPatchModel.AllowedVlans = expandBareMetalServerNicAllowedVlans(d.Get(isBareMetalServerNicAllowedVlans).(*schema.Set))
 	}
 	if d.HasChange(isBareMetalServerNicEnableInfraNAT) {
 		flag = true
@@ -802,7 +794,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceUpdate(context context.Context,
 	}
 
 	if flag {
-		nicPatchModelAsPatch, err := nicPatchModel.AsPatch()
+		nicPatchModelAsPatch, err := bareMetalServerNicPatchAsPatch(nicPatchModel, d.HasChange(isBareMetalServerNicAllowedVlans))
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for BareMetalServerNetworkInterfacePatch %s", err))
 		}
@@ -1038,6 +1030,29 @@ func MakeTerraformNICID(id1, id2 string) string {
 	return fmt.Sprintf("%s/%s", id1, id2)
 }
 
+func expandBareMetalServerNicAllowedVlans(allowedVlansSet *schema.Set) []int64 {
+	allowedVlansList := allowedVlansSet.List()
+	allowedVlans := make([]int64, 0, len(allowedVlansList))
+	for _, k := range allowedVlansList {
+		allowedVlans = append(allowedVlans, int64(k.(int)))
+	}
+	return allowedVlans
+}
+
+// bareMetalServerNicPatchAsPatch converts the patch model to a patch. The
+// allowed_vlans field is omitted by the SDK when it is empty, so it is set
+// explicitly when the allowed VLANs changed, to allow removing all of them.
+func bareMetalServerNicPatchAsPatch(nicPatchModel *vpcv1.BareMetalServerNetworkInterfacePatch, allowedVlansChanged bool) (map[string]interface{}, error) {
+	nicPatch, err := nicPatchModel.AsPatch()
+	if err != nil {
+		return nil, err
+	}
+	if allowedVlansChanged && len(nicPatchModel.AllowedVlans) == 0 {
+		nicPatch["allowed_vlans"] = []int64{}
+	}
+	return nicPatch, nil
+}
+
 func ParseNICTerraformID(s string) (string, string, error) {
 	segments := strings.Split(s, "/")
 	if len(segments) != 2 {
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestBareMetalServerNicsApplyOnce(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			isBareMetalServerNetworkInterfaces: ResourceIBMIsBareMetalServer().Schema[isBareMetalServerNetworkInterfaces],
+		},
+	}
+	state := &terraform.InstanceState{
+		ID: "bms",
+		Attributes: map[string]string{
+			"network_interfaces.#":                                                    "1",
+			"network_interfaces.0.id":                                                 "nic",
+			"network_interfaces.0.name":                                               "nic-name",
+			"network_interfaces.0.subnet":                                             "subnet",
+			"network_interfaces.0.allow_ip_spoofing":                                  "false",
+			"network_interfaces.0.enable_infrastructure_nat":                          "true",
+			"network_interfaces.0.allowed_vlans.#":                                    "1",
+			fmt.Sprintf("network_interfaces.0.allowed_vlans.%d", schema.HashInt(100)): "100",
+		},
+	}
+	nic := func(name, subnet string, vlans ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"name":          name,
+			"subnet":        subnet,
+			"allowed_vlans": vlans,
+		}
+	}
+	cases := []struct {
+		name     string
+		nics     []interface{}
+		wantKeys []string
+	}{
+		{"unchanged", []interface{}{nic("nic-name", "subnet", 100)}, nil},
+		{"subnet", []interface{}{nic("nic-name", "other-subnet", 100)}, nil},
+		{"name", []interface{}{nic("new-name", "subnet", 100)}, []string{"network_interfaces.0.name"}},
+		{"allowed vlans", []interface{}{nic("nic-name", "subnet")}, []string{"network_interfaces.0.allowed_vlans.#"}},
+		{"added interface", []interface{}{nic("nic-name", "subnet", 100), nic("new-nic", "subnet", 200)}, nil},
+	}
+	for _, c := range cases {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{isBareMetalServerNetworkInterfaces: c.nics})
+		diff, err := r.Diff(context.Background(), state, config, nil)
+		if err != nil {
+			t.Fatalf("%s: %s", c.name, err)
+		}
+		for _, k := range c.wantKeys {
+			if diff == nil || diff.Attributes[k] == nil {
+				t.Errorf("%s: no diff for %s in %v", c.name, k, diff)
+			}
+		}
+		if c.wantKeys == nil && diff != nil && len(diff.Attributes) > 0 {
+			t.Errorf("%s: unexpected diff %v", c.name, diff.Attributes)
+		}
+	}
+}
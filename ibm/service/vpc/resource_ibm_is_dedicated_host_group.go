@@ -7,11 +7,16 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -25,6 +30,18 @@ func ResourceIbmIsDedicatedHostGroup() *schema.Resource {
 		DeleteContext: resourceIbmIsDedicatedHostGroupDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIbmIsDedicatedHostGroupCapacityCustomizeDiff(diff, v)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 			"class": {
 				Type:        schema.TypeString,
@@ -127,6 +144,58 @@ func ResourceIbmIsDedicatedHostGroup() *schema.Resource {
 				Computed:    true,
 				Description: "The type of resource referenced.",
 			},
+			"host_capacity": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Provision and release dedicated hosts in this group to maintain the capacity.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"profile": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The globally unique name of the dedicated host profile of the provisioned hosts.",
+						},
+						"desired_hosts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The minimum number of dedicated hosts in this group.",
+						},
+						"spare_vcpu": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The number of VCPUs that must remain available for new instances on the hosts of this group.",
+						},
+						"spare_memory": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The memory (in gibibytes) that must remain available for new instances on the hosts of this group.",
+						},
+					},
+				},
+			},
+			"managed_hosts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The identifiers of the dedicated hosts provisioned for host_capacity.",
+			},
+			"available_vcpu": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of VCPUs available for new instances on the hosts of this group, set when host_capacity or managed_hosts is used.",
+			},
+			"available_memory": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The memory (in gibibytes) available for new instances on the hosts of this group, set when host_capacity or managed_hosts is used.",
+			},
 			"supported_instance_profiles": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -215,6 +284,15 @@ func resourceIbmIsDedicatedHostGroupCreate(context context.Context, d *schema.Re
 
 	d.SetId(*dedicatedHostGroup.ID)
 
+	if _, ok := d.GetOk("host_capacity"); ok {
+		if dedicatedHostGroup.ResourceGroup != nil {
+			d.Set("resource_group", *dedicatedHostGroup.ResourceGroup.ID)
+		}
+		if err = isDedicatedHostGroupMaintainCapacity(context, vpcClient, d, nil, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIbmIsDedicatedHostGroupRead(context, d, meta)
 }
 
@@ -297,6 +375,18 @@ func resourceIbmIsDedicatedHostGroupRead(context context.Context, d *schema.Reso
 	if err = d.Set("resource_type", dedicatedHostGroup.ResourceType); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_type: %s", err))
 	}
+	// The hosts are only listed for groups that maintain a capacity
+	_, hasCapacity := d.GetOk("host_capacity")
+	if hasCapacity || len(d.Get("managed_hosts").([]interface{})) > 0 {
+		hosts, err := isDedicatedHostGroupListHosts(context, vpcClient, d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		capacity := isDedicatedHostGroupAvailableCapacity(hosts)
+		d.Set("available_vcpu", capacity.vcpu)
+		d.Set("available_memory", capacity.memory)
+	}
+
 	supportedInstanceProfiles := []map[string]interface{}{}
 	for _, supportedInstanceProfilesItem := range dedicatedHostGroup.SupportedInstanceProfiles {
 		supportedInstanceProfilesItemMap := resourceIbmIsDedicatedHostGroupInstanceProfileReferenceToMap(supportedInstanceProfilesItem)
@@ -417,6 +507,13 @@ func resourceIbmIsDedicatedHostGroupUpdate(context context.Context, d *schema.Re
 		}
 	}
 
+	if d.HasChange("host_capacity") || d.HasChange("managed_hosts") {
+		managed, _ := d.GetChange("managed_hosts")
+		if err = isDedicatedHostGroupMaintainCapacity(context, vpcClient, d, flex.ExpandStringList(managed.([]interface{})), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIbmIsDedicatedHostGroupRead(context, d, meta)
 }
 
@@ -440,6 +537,12 @@ func resourceIbmIsDedicatedHostGroupDelete(context context.Context, d *schema.Re
 		return diag.FromErr(err)
 	}
 
+	for _, hostID := range flex.ExpandStringList(d.Get("managed_hosts").([]interface{})) {
+		if err = isDedicatedHostGroupReleaseHost(context, vpcClient, hostID, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	deleteDedicatedHostGroupOptions := &vpcv1.DeleteDedicatedHostGroupOptions{}
 
 	deleteDedicatedHostGroupOptions.SetID(d.Id())
@@ -454,3 +557,299 @@ func resourceIbmIsDedicatedHostGroupDelete(context context.Context, d *schema.Re
 
 	return nil
 }
+
+// isDedicatedHostGroupCapacity is the capacity of one or more dedicated hosts,
+// with the memory in gibibytes.
+type isDedicatedHostGroupCapacity struct {
+	vcpu   int64
+	memory int64
+}
+
+// isDedicatedHostGroupCapacityPlan is the change of the hosts of a group that
+// maintains its host_capacity.
+type isDedicatedHostGroupCapacityPlan struct {
+	add     int
+	release []string
+}
+
+func resourceIbmIsDedicatedHostGroupCapacityCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || diff.HasChange("host_capacity") {
+		return nil
+	}
+	if _, ok := diff.GetOk("host_capacity"); !ok {
+		return nil
+	}
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return err
+	}
+	hosts, err := isDedicatedHostGroupListHosts(context.Background(), vpcClient, diff.Id())
+	if err != nil {
+		return err
+	}
+	profileCapacity, err := isDedicatedHostGroupProfileCapacity(context.Background(), vpcClient, diff.Get("host_capacity.0.profile").(string))
+	if err != nil {
+		return err
+	}
+	desiredHosts, spare := isDedicatedHostGroupCapacityTarget(diff.Get)
+	plan := isDedicatedHostGroupPlanCapacity(hosts, profileCapacity, desiredHosts, spare, flex.ExpandStringList(diff.Get("managed_hosts").([]interface{})))
+	if plan.add > 0 || len(plan.release) > 0 {
+		log.Printf("[INFO] Dedicated host group %s needs %d more hosts and can release %d hosts to maintain its host_capacity", diff.Id(), plan.add, len(plan.release))
+		return diff.SetNewComputed("managed_hosts")
+	}
+	return nil
+}
+
+// isDedicatedHostGroupCapacityTarget returns the desired hosts and the spare capacity of the
+// host_capacity block, zero if the block is not set.
+func isDedicatedHostGroupCapacityTarget(get func(string) interface{}) (int, isDedicatedHostGroupCapacity) {
+	if len(get("host_capacity").([]interface{})) == 0 {
+		return 0, isDedicatedHostGroupCapacity{}
+	}
+	return get("host_capacity.0.desired_hosts").(int), isDedicatedHostGroupCapacity{
+		vcpu:   int64(get("host_capacity.0.spare_vcpu").(int)),
+		memory: int64(get("host_capacity.0.spare_memory").(int)),
+	}
+}
+
+// isDedicatedHostGroupMaintainCapacity provisions the hosts that the group
+// needs to meet its host_capacity and releases the managed hosts without
+// instances that it does not need. Without host_capacity, all managed hosts
+// without instances are released.
+func isDedicatedHostGroupMaintainCapacity(context context.Context, vpcClient *vpcv1.VpcV1, d *schema.ResourceData, managed []string, timeout time.Duration) error {
+	hosts, err := isDedicatedHostGroupListHosts(context, vpcClient, d.Id())
+	if err != nil {
+		return err
+	}
+	// Forget managed hosts that were deleted outside of Terraform
+	existing := map[string]bool{}
+	for _, host := range hosts {
+		existing[*host.ID] = true
+	}
+	kept := []string{}
+	for _, hostID := range managed {
+		if existing[hostID] {
+			kept = append(kept, hostID)
+		}
+	}
+
+	desiredHosts, spare := isDedicatedHostGroupCapacityTarget(d.Get)
+	profile := ""
+	profileCapacity := isDedicatedHostGroupCapacity{}
+	if _, ok := d.GetOk("host_capacity"); ok {
+		profile = d.Get("host_capacity.0.profile").(string)
+		profileCapacity, err = isDedicatedHostGroupProfileCapacity(context, vpcClient, profile)
+		if err != nil {
+			return err
+		}
+	}
+	plan := isDedicatedHostGroupPlanCapacity(hosts, profileCapacity, desiredHosts, spare, kept)
+
+	released := map[string]bool{}
+	for _, hostID := range plan.release {
+		if err = isDedicatedHostGroupReleaseHost(context, vpcClient, hostID, timeout); err != nil {
+			d.Set("managed_hosts", kept)
+			return err
+		}
+		released[hostID] = true
+	}
+	managedHosts := []string{}
+	for _, hostID := range kept {
+		if !released[hostID] {
+			managedHosts = append(managedHosts, hostID)
+		}
+	}
+
+	for i := 0; i < plan.add; i++ {
+		dedicatedHostPrototype := &vpcv1.DedicatedHostPrototype{
+			InstancePlacementEnabled: core.BoolPtr(true),
+			Profile: &vpcv1.DedicatedHostProfileIdentity{
+				Name: &profile,
+			},
+			Group: &vpcv1.DedicatedHostGroupIdentity{
+				ID: core.StringPtr(d.Id()),
+			},
+		}
+		if resgroup := d.Get("resource_group").(string); resgroup != "" {
+			dedicatedHostPrototype.ResourceGroup = &vpcv1.ResourceGroupIdentity{
+				ID: &resgroup,
+			}
+		}
+		createDedicatedHostOptions := &vpcv1.CreateDedicatedHostOptions{}
+		createDedicatedHostOptions.SetDedicatedHostPrototype(dedicatedHostPrototype)
+		dedicatedHost, response, err := vpcClient.CreateDedicatedHostWithContext(context, createDedicatedHostOptions)
+		if err != nil {
+			d.Set("managed_hosts", managedHosts)
+			log.Printf("[DEBUG] CreateDedicatedHostWithContext failed %s\n%s", err, response)
+			return fmt.Errorf("[ERROR] Error provisioning dedicated host in group %s: %s\n%s", d.Id(), err, response)
+		}
+		managedHosts = append(managedHosts, *dedicatedHost.ID)
+		if _, err = isWaitForDedicatedHostAvailable(vpcClient, *dedicatedHost.ID, timeout, d); err != nil {
+			d.Set("managed_hosts", managedHosts)
+			return err
+		}
+	}
+	d.Set("managed_hosts", managedHosts)
+	return nil
+}
+
+// isDedicatedHostGroupPlanCapacity computes how many hosts of the profile
+// capacity to add so that the group has desiredHosts hosts and the spare
+// capacity available. If no host is needed, it picks the managed hosts without
+// instances that can be released while keeping both targets.
+func isDedicatedHostGroupPlanCapacity(hosts []vpcv1.DedicatedHost, profileCapacity isDedicatedHostGroupCapacity, desiredHosts int, spare isDedicatedHostGroupCapacity, managed []string) isDedicatedHostGroupCapacityPlan {
+	plan := isDedicatedHostGroupCapacityPlan{}
+	available := isDedicatedHostGroupAvailableCapacity(hosts)
+
+	needed := desiredHosts - len(hosts)
+	if missing := spare.vcpu - available.vcpu; missing > 0 && profileCapacity.vcpu > 0 {
+		if n := int((missing + profileCapacity.vcpu - 1) / profileCapacity.vcpu); n > needed {
+			needed = n
+		}
+	}
+	if missing := spare.memory - available.memory; missing > 0 && profileCapacity.memory > 0 {
+		if n := int((missing + profileCapacity.memory - 1) / profileCapacity.memory); n > needed {
+			needed = n
+		}
+	}
+	if needed > 0 {
+		plan.add = needed
+		return plan
+	}
+
+	hostCount := len(hosts)
+	for _, hostID := range managed {
+		for _, host := range hosts {
+			if *host.ID != hostID || len(host.Instances) > 0 {
+				continue
+			}
+			hostCapacity := isDedicatedHostGroupAvailableCapacity([]vpcv1.DedicatedHost{host})
+			if hostCount-1 >= desiredHosts && available.vcpu-hostCapacity.vcpu >= spare.vcpu && available.memory-hostCapacity.memory >= spare.memory {
+				plan.release = append(plan.release, hostID)
+				hostCount--
+				available.vcpu -= hostCapacity.vcpu
+				available.memory -= hostCapacity.memory
+			}
+		}
+	}
+	return plan
+}
+
+// isDedicatedHostGroupAvailableCapacity sums the capacity available for new
+// instances on the hosts that accept instances.
+func isDedicatedHostGroupAvailableCapacity(hosts []vpcv1.DedicatedHost) isDedicatedHostGroupCapacity {
+	capacity := isDedicatedHostGroupCapacity{}
+	for _, host := range hosts {
+		if host.InstancePlacementEnabled == nil || !*host.InstancePlacementEnabled || host.LifecycleState == nil || *host.LifecycleState == isDedicatedHostFailed || *host.LifecycleState == isDedicatedHostSuspended {
+			continue
+		}
+		if host.AvailableVcpu != nil && host.AvailableVcpu.Count != nil {
+			capacity.vcpu += *host.AvailableVcpu.Count
+		}
+		if host.AvailableMemory != nil {
+			capacity.memory += *host.AvailableMemory
+		}
+	}
+	return capacity
+}
+
+func isDedicatedHostGroupProfileCapacity(context context.Context, vpcClient *vpcv1.VpcV1, profile string) (isDedicatedHostGroupCapacity, error) {
+	capacity := isDedicatedHostGroupCapacity{}
+	getDedicatedHostProfileOptions := &vpcv1.GetDedicatedHostProfileOptions{
+		Name: &profile,
+	}
+	dedicatedHostProfile, response, err := vpcClient.GetDedicatedHostProfileWithContext(context, getDedicatedHostProfileOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return capacity, fmt.Errorf("[ERROR] Dedicated host profile %s of host_capacity not found", profile)
+		}
+		return capacity, fmt.Errorf("[ERROR] Error getting dedicated host profile %s: %s\n%s", profile, err, response)
+	}
+	if vcpu, ok := dedicatedHostProfile.VcpuCount.(*vpcv1.DedicatedHostProfileVcpu); ok {
+		if vcpu.Value != nil {
+			capacity.vcpu = *vcpu.Value
+		} else if vcpu.Default != nil {
+			capacity.vcpu = *vcpu.Default
+		}
+	}
+	if memory, ok := dedicatedHostProfile.Memory.(*vpcv1.DedicatedHostProfileMemory); ok {
+		if memory.Value != nil {
+			capacity.memory = *memory.Value
+		} else if memory.Default != nil {
+			capacity.memory = *memory.Default
+		}
+	}
+	return capacity, nil
+}
+
+func isDedicatedHostGroupListHosts(context context.Context, vpcClient *vpcv1.VpcV1, groupID string) ([]vpcv1.DedicatedHost, error) {
+	start := ""
+	hosts := []vpcv1.DedicatedHost{}
+	for {
+		listDedicatedHostsOptions := &vpcv1.ListDedicatedHostsOptions{
+			DedicatedHostGroupID: &groupID,
+		}
+		if start != "" {
+			listDedicatedHostsOptions.Start = &start
+		}
+		dedicatedHostCollection, response, err := vpcClient.ListDedicatedHostsWithContext(context, listDedicatedHostsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListDedicatedHostsWithContext failed %s\n%s", err, response)
+			return nil, fmt.Errorf("[ERROR] Error listing dedicated hosts of group %s: %s\n%s", groupID, err, response)
+		}
+		start = flex.GetNext(dedicatedHostCollection.Next)
+		hosts = append(hosts, dedicatedHostCollection.DedicatedHosts...)
+		if start == "" {
+			break
+		}
+	}
+	return hosts, nil
+}
+
+// isDedicatedHostGroupReleaseHost disables instance placement on a managed host
+// and deletes it.
+func isDedicatedHostGroupReleaseHost(context context.Context, vpcClient *vpcv1.VpcV1, hostID string, timeout time.Duration) error {
+	updateDedicatedHostOptions := &vpcv1.UpdateDedicatedHostOptions{}
+	updateDedicatedHostOptions.SetID(hostID)
+	updateDedicatedHostOptions.SetDedicatedHostPatch(map[string]interface{}{
+		"instance_placement_enabled": core.BoolPtr(false),
+	})
+	_, response, err := vpcClient.UpdateDedicatedHostWithContext(context, updateDedicatedHostOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		log.Printf("[DEBUG] Failed disabling instance placement %s\n%s", err, response)
+		return fmt.Errorf("[ERROR] Error disabling instance placement on dedicated host %s: %s\n%s", hostID, err, response)
+	}
+	deleteDedicatedHostOptions := &vpcv1.DeleteDedicatedHostOptions{}
+	deleteDedicatedHostOptions.SetID(hostID)
+	response, err = vpcClient.DeleteDedicatedHostWithContext(context, deleteDedicatedHostOptions)
+	if err != nil {
+		log.Printf("[DEBUG] DeleteDedicatedHostWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("[ERROR] Error releasing dedicated host %s: %s\n%s", hostID, err, response)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isDedicatedHostDeleting},
+		Target:  []string{isDedicatedHostDeleteDone},
+		Refresh: func() (interface{}, string, error) {
+			dedicatedHost, response, err := vpcClient.GetDedicatedHostWithContext(context, &vpcv1.GetDedicatedHostOptions{ID: &hostID})
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return dedicatedHost, isDedicatedHostDeleteDone, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting dedicated host %s: %s\n%s", hostID, err, response)
+			}
+			if *dedicatedHost.LifecycleState == isDedicatedHostFailed {
+				return dedicatedHost, *dedicatedHost.LifecycleState, fmt.Errorf("[ERROR] The dedicated host %s failed to delete", hostID)
+			}
+			return dedicatedHost, isDedicatedHostDeleting, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
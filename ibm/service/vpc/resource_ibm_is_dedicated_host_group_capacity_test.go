@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func testDedicatedHost(id, state string, vcpu, memory int64, instances int) vpcv1.DedicatedHost {
+	return vpcv1.DedicatedHost{
+		ID:                       core.StringPtr(id),
+		LifecycleState:           core.StringPtr(state),
+		InstancePlacementEnabled: core.BoolPtr(true),
+		AvailableVcpu:            &vpcv1.Vcpu{Count: core.Int64Ptr(vcpu)},
+		AvailableMemory:          core.Int64Ptr(memory),
+		Instances:                make([]vpcv1.InstanceReference, instances),
+	}
+}
+
+func TestIsDedicatedHostGroupPlanCapacity(t *testing.T) {
+	profile := isDedicatedHostGroupCapacity{vcpu: 16, memory: 64}
+	cases := []struct {
+		name         string
+		hosts        []vpcv1.DedicatedHost
+		desiredHosts int
+		spare        isDedicatedHostGroupCapacity
+		managed      []string
+		want         isDedicatedHostGroupCapacityPlan
+	}{
+		{
+			name:         "empty group",
+			desiredHosts: 2,
+			want:         isDedicatedHostGroupCapacityPlan{add: 2},
+		},
+		{
+			name:  "spare vcpu",
+			hosts: []vpcv1.DedicatedHost{testDedicatedHost("h1", isDedicatedHostStable, 4, 64, 1)},
+			spare: isDedicatedHostGroupCapacity{vcpu: 20},
+			want:  isDedicatedHostGroupCapacityPlan{add: 1},
+		},
+		{
+			name:  "spare memory",
+			hosts: []vpcv1.DedicatedHost{testDedicatedHost("h1", isDedicatedHostStable, 16, 8, 1)},
+			spare: isDedicatedHostGroupCapacity{vcpu: 16, memory: 100},
+			want:  isDedicatedHostGroupCapacityPlan{add: 2},
+		},
+		{
+			name:         "failed host has no capacity",
+			hosts:        []vpcv1.DedicatedHost{testDedicatedHost("h1", isDedicatedHostFailed, 16, 64, 0)},
+			desiredHosts: 1,
+			spare:        isDedicatedHostGroupCapacity{vcpu: 8},
+			want:         isDedicatedHostGroupCapacityPlan{add: 1},
+		},
+		{
+			name: "release empty managed host",
+			hosts: []vpcv1.DedicatedHost{
+				testDedicatedHost("h1", isDedicatedHostStable, 16, 64, 0),
+				testDedicatedHost("h2", isDedicatedHostStable, 16, 64, 0),
+			},
+			desiredHosts: 1,
+			spare:        isDedicatedHostGroupCapacity{vcpu: 8},
+			managed:      []string{"h2"},
+			want:         isDedicatedHostGroupCapacityPlan{release: []string{"h2"}},
+		},
+		{
+			name: "keep managed host with instances",
+			hosts: []vpcv1.DedicatedHost{
+				testDedicatedHost("h1", isDedicatedHostStable, 16, 64, 0),
+				testDedicatedHost("h2", isDedicatedHostStable, 8, 32, 1),
+			},
+			desiredHosts: 1,
+			managed:      []string{"h2"},
+			want:         isDedicatedHostGroupCapacityPlan{},
+		},
+		{
+			name: "keep managed host needed for spare",
+			hosts: []vpcv1.DedicatedHost{
+				testDedicatedHost("h1", isDedicatedHostStable, 16, 64, 0),
+				testDedicatedHost("h2", isDedicatedHostStable, 16, 64, 0),
+			},
+			desiredHosts: 1,
+			spare:        isDedicatedHostGroupCapacity{vcpu: 24},
+			managed:      []string{"h1", "h2"},
+			want:         isDedicatedHostGroupCapacityPlan{},
+		},
+		{
+			name: "release down to desired hosts",
+			hosts: []vpcv1.DedicatedHost{
+				testDedicatedHost("h1", isDedicatedHostStable, 16, 64, 0),
+				testDedicatedHost("h2", isDedicatedHostStable, 16, 64, 0),
+				testDedicatedHost("h3", isDedicatedHostStable, 16, 64, 0),
+			},
+			desiredHosts: 1,
+			managed:      []string{"h1", "h2", "h3"},
+			want:         isDedicatedHostGroupCapacityPlan{release: []string{"h1", "h2"}},
+		},
+	}
+	for _, c := range cases {
+		got := isDedicatedHostGroupPlanCapacity(c.hosts, profile, c.desiredHosts, c.spare, c.managed)
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: got %+v, want %+v", c.name, got, c.want)
+		}
+	}
+}
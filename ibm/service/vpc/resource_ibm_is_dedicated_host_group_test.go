@@ -76,6 +76,33 @@ func TestAccIbmIsDedicatedHostGroupAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIbmIsDedicatedHostGroupHostCapacity(t *testing.T) {
+	var conf vpcv1.DedicatedHostGroup
+	name := fmt.Sprintf("tfdhgroup%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmIsDedicatedHostGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmIsDedicatedHostGroupConfigHostCapacity(acc.DedicatedHostGroupClass, acc.DedicatedHostGroupFamily, name, acc.DedicatedHostProfileName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmIsDedicatedHostGroupExists("ibm_is_dedicated_host_group.is_dedicated_host_group", conf),
+					resource.TestCheckResourceAttr("ibm_is_dedicated_host_group.is_dedicated_host_group", "managed_hosts.#", "1"),
+					resource.TestCheckResourceAttr("ibm_is_dedicated_host_group.is_dedicated_host_group", "dedicated_hosts.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIbmIsDedicatedHostGroupConfigHostCapacity(acc.DedicatedHostGroupClass, acc.DedicatedHostGroupFamily, name, acc.DedicatedHostProfileName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_dedicated_host_group.is_dedicated_host_group", "managed_hosts.#", "0"),
+					resource.TestCheckResourceAttr("ibm_is_dedicated_host_group.is_dedicated_host_group", "available_vcpu", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIbmIsDedicatedHostGroupConfigBasic(class string, family string, name string) string {
 	return fmt.Sprintf(`
 
@@ -92,6 +119,26 @@ func testAccCheckIbmIsDedicatedHostGroupConfigBasic(class string, family string,
 	`, class, family, name)
 }
 
+func testAccCheckIbmIsDedicatedHostGroupConfigHostCapacity(class string, family string, name string, profile string, desiredHosts int) string {
+	return fmt.Sprintf(`
+
+		data "ibm_resource_group" "default" {
+			is_default=true
+		}
+		resource "ibm_is_dedicated_host_group" "is_dedicated_host_group" {
+			class = "%s"
+			family = "%s"
+			name = "%s"
+			resource_group = data.ibm_resource_group.default.id
+			zone = "us-south-2"
+			host_capacity {
+				profile = "%s"
+				desired_hosts = %d
+			}
+		}
+	`, class, family, name, profile, desiredHosts)
+}
+
 func testAccCheckIbmIsDedicatedHostGroupExists(n string, obj vpcv1.DedicatedHostGroup) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
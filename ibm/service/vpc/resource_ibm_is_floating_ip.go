@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"reflect"
 	"time"
 
@@ -53,7 +52,7 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -347,8 +346,8 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isFloatingIPTags); ok || v != "" {
+	v := flex.EnvTags(meta)
+	if _, ok := d.GetOk(isFloatingIPTags); ok || len(v) > 0 {
 		oldList, newList := d.GetChange(isFloatingIPTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *floatingip.CRN)
 		if err != nil {
@@ -7,14 +7,16 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
@@ -51,7 +53,7 @@ func ResourceIBMISFlowLog() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -71,6 +73,55 @@ func ResourceIBMISFlowLog() *schema.Resource {
 				Description: "The Cloud Object Storage bucket name where the collected flows will be logged",
 			},
 
+			isFlowLogManagedBucket: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Create the storage bucket, and the authorization of flow log collectors to write to it, with the flow log collector",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isFlowLogManagedBucketCosInstance: {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The CRN of the Cloud Object Storage instance of the bucket",
+						},
+						isFlowLogManagedBucketRegion: {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The region of the bucket, for example us-south",
+						},
+						isFlowLogManagedBucketStorageClass: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "smart",
+							ValidateFunc: validation.StringInSlice([]string{"standard", "vault", "cold", "smart"}, false),
+							Description:  "The storage class of the bucket",
+						},
+						isFlowLogManagedBucketRetentionDays: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The number of days after which the flow logs expire, flow logs do not expire if not set",
+						},
+						isFlowLogManagedBucketForceDelete: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Delete the flow logs in the bucket when the flow log collector is deleted",
+						},
+						isFlowLogManagedBucketAuthorizationID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the authorization policy created for the bucket, empty if the flow log collectors were already authorized. The policy is shared by the flow log collectors writing to the instance and is not deleted with the flow log collector",
+						},
+					},
+				},
+			},
+
 			isFlowLogTarget: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -229,16 +280,41 @@ func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error
 	cloudObjectStorageBucketIdentityModel.Name = &bucketname
 	createFlowLogCollectorOptionsModel.StorageBucket = cloudObjectStorageBucketIdentityModel
 
-	flowlogCollector, response, err := sess.CreateFlowLogCollector(createFlowLogCollectorOptionsModel)
+	_, managed := isFlowLogManagedBucketConfig(d)
+	if managed {
+		if err = isFlowLogCreateManagedBucket(d, meta); err != nil {
+			return err
+		}
+	}
+
+	var flowlogCollector *vpcv1.FlowLogCollector
+	var response *core.DetailedResponse
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		flowlogCollector, response, err = sess.CreateFlowLogCollector(createFlowLogCollectorOptionsModel)
+		if err != nil {
+			// The authorization of a managed bucket takes a while to be effective
+			if managed && isFlowLogAuthorizationPending(response, err) {
+				log.Printf("[DEBUG] Retrying the creation of the flow log collector: %s", err)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
 	if err != nil {
+		if managed {
+			if cleanupErr := isFlowLogDeleteManagedBucket(d, meta); cleanupErr != nil {
+				log.Printf("[WARN] Error deleting the managed bucket of the flow log collector that failed to create: %s", cleanupErr)
+			}
+		}
 		return fmt.Errorf("Create Flow Log Collector err %s\n%s", err, response)
 	}
 	d.SetId(*flowlogCollector.ID)
 
 	log.Printf("Flow log collector : %s", *flowlogCollector.ID)
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isFlowLogTags); ok || v != "" {
+	v := flex.EnvTags(meta)
+	if _, ok := d.GetOk(isFlowLogTags); ok || len(v) > 0 {
 		oldList, newList := d.GetChange(isFlowLogTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN)
 		if err != nil {
@@ -396,6 +472,15 @@ func resourceIBMISFlowLogDelete(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("[ERROR] Error deleting flow log collector:%s\n%s", err, response)
 	}
 
+	if _, ok := isFlowLogManagedBucketConfig(d); ok {
+		if err = isFlowLogWaitForDeleted(sess, ID, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return err
+		}
+		if err = isFlowLogDeleteManagedBucket(d, meta); err != nil {
+			return err
+		}
+	}
+
 	d.SetId("")
 	return nil
 }
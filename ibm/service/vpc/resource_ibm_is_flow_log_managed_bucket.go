@@ -0,0 +1,306 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cos"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
+	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isFlowLogManagedBucket                = "managed_bucket"
+	isFlowLogManagedBucketCosInstance     = "cos_instance_crn"
+	isFlowLogManagedBucketRegion          = "region"
+	isFlowLogManagedBucketStorageClass    = "storage_class"
+	isFlowLogManagedBucketRetentionDays   = "retention_days"
+	isFlowLogManagedBucketForceDelete     = "force_delete"
+	isFlowLogManagedBucketAuthorizationID = "authorization_policy_id"
+
+	isFlowLogWriterRole = "crn:v1:bluemix:public:iam::::serviceRole:Writer"
+)
+
+// In managed bucket mode the flow log collector creates its storage bucket, and
+// the authorization for flow log collectors to write to the Cloud Object
+// Storage instance, before it is created and deletes the bucket after it is
+// deleted. The authorization applies to all the flow log collectors of the
+// account that write to the instance, so it is kept.
+
+func isFlowLogManagedBucketConfig(d *schema.ResourceData) (map[string]interface{}, bool) {
+	managed, ok := d.GetOk(isFlowLogManagedBucket)
+	if !ok || len(managed.([]interface{})) == 0 || managed.([]interface{})[0] == nil {
+		return nil, false
+	}
+	return managed.([]interface{})[0].(map[string]interface{}), true
+}
+
+// isFlowLogCreateManagedBucket creates the storage bucket of the flow log
+// collector, its expiration rule and the authorization policy, and records the
+// ID of the policy in the managed_bucket block. The bucket is deleted again if
+// any of the other steps fails.
+func isFlowLogCreateManagedBucket(d *schema.ResourceData, meta interface{}) error {
+	managed, ok := isFlowLogManagedBucketConfig(d)
+	if !ok {
+		return nil
+	}
+	instanceCRN := managed[isFlowLogManagedBucketCosInstance].(string)
+	region := managed[isFlowLogManagedBucketRegion].(string)
+	bucketName := d.Get(isFlowLogStorageBucket).(string)
+
+	s3Client, err := isFlowLogManagedBucketClient(meta, instanceCRN, region)
+	if err != nil {
+		return err
+	}
+	location := fmt.Sprintf("%s-%s", region, managed[isFlowLogManagedBucketStorageClass].(string))
+	if err = isFlowLogCreateBucket(s3Client, bucketName, location, managed[isFlowLogManagedBucketRetentionDays].(int)); err != nil {
+		return err
+	}
+
+	policyID, err := isFlowLogCreateAuthorization(meta, instanceCRN)
+	if err != nil {
+		isFlowLogCleanupBucket(s3Client, bucketName)
+		return err
+	}
+	managed[isFlowLogManagedBucketAuthorizationID] = policyID
+	d.Set(isFlowLogManagedBucket, []interface{}{managed})
+	return nil
+}
+
+// isFlowLogCreateBucket creates the bucket in location with an expiration rule
+// of retentionDays, if set, and deletes the bucket if the rule cannot be set.
+func isFlowLogCreateBucket(s3Client *s3.S3, bucketName, location string, retentionDays int) error {
+	_, err := s3Client.CreateBucket(&s3.CreateBucketInput{
+		Bucket: aws.String(bucketName),
+		CreateBucketConfiguration: &s3.CreateBucketConfiguration{
+			LocationConstraint: aws.String(location),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating the flow log bucket %s: %s", bucketName, err)
+	}
+	if retentionDays <= 0 {
+		return nil
+	}
+	_, err = s3Client.PutBucketLifecycleConfiguration(&s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucketName),
+		LifecycleConfiguration: &s3.LifecycleConfiguration{
+			Rules: []*s3.LifecycleRule{
+				{
+					ID:         aws.String("flow-log-retention"),
+					Status:     aws.String("Enabled"),
+					Filter:     &s3.LifecycleRuleFilter{},
+					Expiration: &s3.LifecycleExpiration{Days: aws.Int64(int64(retentionDays))},
+				},
+			},
+		},
+	})
+	if err != nil {
+		isFlowLogCleanupBucket(s3Client, bucketName)
+		return fmt.Errorf("[ERROR] Error setting the retention of the flow log bucket %s: %s", bucketName, err)
+	}
+	return nil
+}
+
+// isFlowLogCleanupBucket deletes the empty bucket of a flow log collector that
+// failed to create.
+func isFlowLogCleanupBucket(s3Client *s3.S3, bucketName string) {
+	_, err := s3Client.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String(bucketName)})
+	if err != nil && !strings.Contains(err.Error(), "NoSuchBucket") {
+		log.Printf("[WARN] Error deleting the managed bucket %s of the flow log collector that failed to create: %s", bucketName, err)
+	}
+}
+
+// isFlowLogDeleteManagedBucket deletes the storage bucket of the flow log
+// collector, with its objects if force_delete is set. The authorization policy
+// is shared with the other flow log collectors writing to the instance and is
+// not deleted.
+func isFlowLogDeleteManagedBucket(d *schema.ResourceData, meta interface{}) error {
+	managed, ok := isFlowLogManagedBucketConfig(d)
+	if !ok {
+		return nil
+	}
+	bucketName := d.Get(isFlowLogStorageBucket).(string)
+	s3Client, err := isFlowLogManagedBucketClient(meta, managed[isFlowLogManagedBucketCosInstance].(string), managed[isFlowLogManagedBucketRegion].(string))
+	if err != nil {
+		return err
+	}
+	if managed[isFlowLogManagedBucketForceDelete].(bool) {
+		var deleteErr error
+		err = s3Client.ListObjectsV2Pages(&s3.ListObjectsV2Input{Bucket: aws.String(bucketName)}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+			if len(page.Contents) == 0 {
+				return true
+			}
+			objects := make([]*s3.ObjectIdentifier, 0, len(page.Contents))
+			for _, object := range page.Contents {
+				objects = append(objects, &s3.ObjectIdentifier{Key: object.Key})
+			}
+			_, deleteErr = s3Client.DeleteObjects(&s3.DeleteObjectsInput{
+				Bucket: aws.String(bucketName),
+				Delete: &s3.Delete{Objects: objects, Quiet: aws.Bool(true)},
+			})
+			return deleteErr == nil
+		})
+		if err == nil {
+			err = deleteErr
+		}
+		if err != nil && !strings.Contains(err.Error(), "NoSuchBucket") {
+			return fmt.Errorf("[ERROR] Error deleting the objects of the flow log bucket %s: %s", bucketName, err)
+		}
+	}
+	_, err = s3Client.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String(bucketName)})
+	if err != nil && !strings.Contains(err.Error(), "NoSuchBucket") {
+		if strings.Contains(err.Error(), "BucketNotEmpty") {
+			return fmt.Errorf("[ERROR] The flow log bucket %s is not empty, set force_delete in managed_bucket to delete its flow logs: %s", bucketName, err)
+		}
+		return fmt.Errorf("[ERROR] Error deleting the flow log bucket %s: %s", bucketName, err)
+	}
+	return nil
+}
+
+// isFlowLogAuthorizationPending reports whether the creation of a flow log
+// collector failed because the authorization to write to its bucket is not
+// effective yet, which is only the case for a while after it is created.
+func isFlowLogAuthorizationPending(response *core.DetailedResponse, err error) bool {
+	if err == nil || response == nil || (response.StatusCode != 400 && response.StatusCode != 403) {
+		return false
+	}
+	if strings.Contains(strings.ToLower(err.Error()), "authoriz") {
+		return true
+	}
+	result, ok := response.Result.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	errs, _ := result["errors"].([]interface{})
+	for _, e := range errs {
+		if e, ok := e.(map[string]interface{}); ok {
+			if code, ok := e["code"].(string); ok && strings.Contains(strings.ToLower(code), "authoriz") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isFlowLogCreateAuthorization authorizes the flow log collectors of the account
+// to write to the Cloud Object Storage instance.
+func isFlowLogCreateAuthorization(meta interface{}, instanceCRN string) (string, error) {
+	crnParts := strings.Split(instanceCRN, ":")
+	if len(crnParts) < 8 || crnParts[7] == "" {
+		return "", fmt.Errorf("[ERROR] %s is not the CRN of a Cloud Object Storage instance", instanceCRN)
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return "", err
+	}
+	iampapClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return "", err
+	}
+	subject := iampolicymanagementv1.PolicySubject{
+		Attributes: []iampolicymanagementv1.SubjectAttribute{
+			{Name: core.StringPtr("accountId"), Value: core.StringPtr(userDetails.UserAccount)},
+			{Name: core.StringPtr("serviceName"), Value: core.StringPtr("is")},
+			{Name: core.StringPtr("resourceType"), Value: core.StringPtr("flow-log-collector")},
+		},
+	}
+	policyResource := iampolicymanagementv1.PolicyResource{
+		Attributes: []iampolicymanagementv1.ResourceAttribute{
+			{Name: core.StringPtr("accountId"), Value: core.StringPtr(userDetails.UserAccount), Operator: core.StringPtr("stringEquals")},
+			{Name: core.StringPtr("serviceName"), Value: core.StringPtr("cloud-object-storage"), Operator: core.StringPtr("stringEquals")},
+			{Name: core.StringPtr("serviceInstance"), Value: core.StringPtr(crnParts[7])},
+		},
+	}
+	createPolicyOptions := iampapClient.NewCreatePolicyOptions(
+		"authorization",
+		[]iampolicymanagementv1.PolicySubject{subject},
+		[]iampolicymanagementv1.PolicyRole{{RoleID: core.StringPtr(isFlowLogWriterRole)}},
+		[]iampolicymanagementv1.PolicyResource{policyResource},
+	)
+	createPolicyOptions.Description = core.StringPtr("Created by ibm_is_flow_log for its managed bucket")
+	policy, resp, err := iampapClient.CreatePolicy(createPolicyOptions)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 409 {
+			// The flow log collectors of the account are already authorized
+			log.Printf("[INFO] Flow log collectors are already authorized to write to %s", instanceCRN)
+			return "", nil
+		}
+		return "", fmt.Errorf("[ERROR] Error creating the flow log authorization policy: %s\n%s", err, resp)
+	}
+	return *policy.ID, nil
+}
+
+func isFlowLogManagedBucketClient(meta interface{}, instanceCRN, region string) (*s3.S3, error) {
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	apiEndpoint, _, _ := cos.SelectCosApi("rl", region)
+	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
+	authEndpoint, err := bxSession.Config.EndpointLocator.IAMEndpoint()
+	if err != nil {
+		return nil, err
+	}
+	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
+
+	var s3Conf *aws.Config
+	apiKey := bxSession.Config.BluemixAPIKey
+	if apiKey != "" {
+		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), authEndpointPath, apiKey, instanceCRN)).WithS3ForcePathStyle(true)
+	} else {
+		initFunc := func() (*token.Token, error) {
+			return &token.Token{
+				AccessToken:  bxSession.Config.IAMAccessToken,
+				RefreshToken: bxSession.Config.IAMRefreshToken,
+				TokenType:    "Bearer",
+				ExpiresIn:    int64((time.Hour * 248).Seconds()) * -1,
+				Expiration:   time.Now().Add(-1 * time.Hour).Unix(),
+			}, nil
+		}
+		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, instanceCRN)).WithS3ForcePathStyle(true)
+	}
+	return s3.New(session.Must(session.NewSession()), s3Conf), nil
+}
+
+// isFlowLogWaitForDeleted waits for the flow log collector to be deleted, so
+// that it does not write to its managed bucket anymore.
+func isFlowLogWaitForDeleted(sess *vpcv1.VpcV1, id string, timeout time.Duration) error {
+	log.Printf("Waiting for flow log collector (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"deleting"},
+		Target:  []string{"deleted"},
+		Refresh: func() (interface{}, string, error) {
+			flowlogCollector, response, err := sess.GetFlowLogCollector(&vpcv1.GetFlowLogCollectorOptions{
+				ID: &id,
+			})
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return id, "deleted", nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting flow log collector %s: %s\n%s", id, err, response)
+			}
+			return flowlogCollector, "deleting", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"errors"
+	gohttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+func TestIsFlowLogAuthorizationPending(t *testing.T) {
+	codeResult := func(code string) map[string]interface{} {
+		return map[string]interface{}{
+			"errors": []interface{}{map[string]interface{}{"code": code, "message": "failed"}},
+		}
+	}
+	cases := []struct {
+		name     string
+		response *core.DetailedResponse
+		err      error
+		want     bool
+	}{
+		{"no error", &core.DetailedResponse{StatusCode: 201}, nil, false},
+		{"no response", nil, errors.New("connection reset"), false},
+		{"message", &core.DetailedResponse{StatusCode: 400}, errors.New("The bucket is not authorized for flow logs"), true},
+		{"code", &core.DetailedResponse{StatusCode: 403, Result: codeResult("storage_bucket_not_authorized")}, errors.New("failed"), true},
+		{"other bad request", &core.DetailedResponse{StatusCode: 400, Result: codeResult("invalid_target")}, errors.New("target is invalid"), false},
+		{"other status", &core.DetailedResponse{StatusCode: 500}, errors.New("not authorized"), false},
+	}
+	for _, c := range cases {
+		if got := isFlowLogAuthorizationPending(c.response, c.err); got != c.want {
+			t.Errorf("%s: got %t, want %t", c.name, got, c.want)
+		}
+	}
+}
+
+func TestIsFlowLogCreateBucket(t *testing.T) {
+	cases := []struct {
+		name          string
+		retentionDays int
+		lifecycleCode int
+		wantErr       bool
+		wantDeleted   bool
+	}{
+		{"without retention", 0, gohttp.StatusOK, false, false},
+		{"with retention", 30, gohttp.StatusOK, false, false},
+		{"retention fails", 30, gohttp.StatusInternalServerError, true, true},
+	}
+	for _, c := range cases {
+		var lock sync.Mutex
+		requests := []string{}
+		server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+			lock.Lock()
+			requests = append(requests, r.Method+" "+r.URL.Path+"?"+r.URL.RawQuery)
+			lock.Unlock()
+			switch {
+			case r.Method == gohttp.MethodPut && strings.Contains(r.URL.RawQuery, "lifecycle"):
+				w.WriteHeader(c.lifecycleCode)
+			case r.Method == gohttp.MethodDelete:
+				w.WriteHeader(gohttp.StatusNoContent)
+			default:
+				w.WriteHeader(gohttp.StatusOK)
+			}
+		}))
+
+		s3Client := s3.New(session.Must(session.NewSession()), aws.NewConfig().
+			WithEndpoint(server.URL).
+			WithRegion("us-south").
+			WithCredentials(credentials.AnonymousCredentials).
+			WithS3ForcePathStyle(true).
+			WithMaxRetries(0))
+		err := isFlowLogCreateBucket(s3Client, "flow-logs", "us-south-smart", c.retentionDays)
+		server.Close()
+
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: unexpected error %v", c.name, err)
+		}
+		deleted := false
+		for _, r := range requests {
+			if strings.HasPrefix(r, gohttp.MethodDelete+" /flow-logs") {
+				deleted = true
+			}
+		}
+		if deleted != c.wantDeleted {
+			t.Errorf("%s: bucket deleted %t, want %t, requests %v", c.name, deleted, c.wantDeleted, requests)
+		}
+	}
+}
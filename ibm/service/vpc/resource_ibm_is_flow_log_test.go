@@ -65,6 +65,63 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 	)
 }
 
+func TestAccIBMISFlowLog_managedBucket(t *testing.T) {
+	var instance string
+	vpcname := fmt.Sprintf("flowlog-vpc-%d", acctest.RandIntRange(10, 100))
+	flowlogname := fmt.Sprintf("flowlog-instance-%d", acctest.RandIntRange(10, 100))
+	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISFlowLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISFlowLogManagedBucketConfig(vpcname, serviceName, bucketName, flowlogname),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISFlowLogExists("ibm_is_flow_log.test_flow_log", instance),
+					resource.TestCheckResourceAttr("ibm_is_flow_log.test_flow_log", "storage_bucket", bucketName),
+					resource.TestCheckResourceAttr("ibm_is_flow_log.test_flow_log", "managed_bucket.0.retention_days", "7"),
+				),
+			},
+		},
+	},
+	)
+}
+
+func testAccCheckIBMISFlowLogManagedBucketConfig(vpcname, serviceName, bucketName, flowlogname string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	data "ibm_resource_group" "cos_group" {
+		is_default=true
+	}
+
+	resource "ibm_resource_instance" "instance2" {
+		name              = "%s"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+	}
+
+	resource "ibm_is_flow_log" "test_flow_log" {
+		name           = "%s"
+		target         = ibm_is_vpc.testacc_vpc.id
+		storage_bucket = "%s"
+		managed_bucket {
+			cos_instance_crn = ibm_resource_instance.instance2.id
+			region           = "us-south"
+			retention_days   = 7
+			force_delete     = true
+		}
+	}
+	`, vpcname, serviceName, flowlogname, bucketName)
+}
+
 func testAccCheckIBMISFlowLogConfig(vpcname, name, flowlogname, sshname, publicKey, subnetname, serviceName, bucketName, bucketRegionType, bucketRegion, bucketClass string, isActive bool) string {
 	return fmt.Sprintf(`	  	
 	
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -59,7 +58,7 @@ func ResourceIBMISImage() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -294,8 +293,8 @@ func imgCreateByFile(d *schema.ResourceData, meta interface{}, href, name, opera
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isImageTags); ok || v != "" {
+	v := flex.EnvTags(meta)
+	if _, ok := d.GetOk(isImageTags); ok || len(v) > 0 {
 		oldList, newList := d.GetChange(isImageTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *image.CRN)
 		if err != nil {
@@ -383,8 +382,8 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isImageTags); ok || v != "" {
+	v := flex.EnvTags(meta)
+	if _, ok := d.GetOk(isImageTags); ok || len(v) > 0 {
 		oldList, newList := d.GetChange(isImageTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *image.CRN)
 		if err != nil {
@@ -8,12 +8,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -84,6 +85,12 @@ const (
 	isInstanceStatusFailed               = "failed"
 	isInstanceAvailablePolicyHostFailure = "availability_policy_host_failure"
 
+	isInstancePreDestroy                  = "pre_destroy"
+	isInstancePreDestroyShutdownTimeout   = "shutdown_timeout"
+	isInstancePreDestroyForceStop         = "force_stop"
+	isInstancePreDestroyFinalSnapshot     = "final_snapshot"
+	isInstancePreDestroyFinalSnapshotName = "final_snapshot_name"
+
 	isInstanceBootAttachmentName = "name"
 	isInstanceBootVolumeId       = "volume_id"
 	isInstanceBootSize           = "size"
@@ -105,6 +112,21 @@ const (
 	isInstanceDefaultTrustedProfileAutoLink = "default_trusted_profile_auto_link"
 	isInstanceDefaultTrustedProfileTarget   = "default_trusted_profile_target"
 	isInstanceMetadataServiceEnabled        = "metadata_service_enabled"
+
+	isInstanceUserDataPreset           = "user_data_preset"
+	isInstanceUserDataPresetNvidiaGpu  = "nvidia_gpu_driver"
+	isInstanceUserDataNvidiaGpuDrivers = `#cloud-config
+# Installs the recommended NVIDIA GPU driver, supported on Ubuntu images
+package_update: true
+packages:
+  - ubuntu-drivers-common
+runcmd:
+  - ubuntu-drivers install --gpgpu || ubuntu-drivers autoinstall
+power_state:
+  mode: reboot
+  message: Rebooting to load the NVIDIA GPU driver
+  condition: true
+`
 )
 
 func ResourceIBMISInstance() *schema.Resource {
@@ -155,8 +177,9 @@ func ResourceIBMISInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
+			resourceIBMISInstanceProfileCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -294,6 +317,41 @@ func ResourceIBMISInstance() *schema.Resource {
 				Description:      "Enables stopping of instance before deleting and waits till deletion is complete",
 			},
 
+			isInstancePreDestroy: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The actions to run before the instance is deleted, or replaced",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isInstancePreDestroyShutdownTimeout: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     10,
+							Description: "Time in minutes to wait for the instance to shut down gracefully before it is deleted",
+						},
+						isInstancePreDestroyForceStop: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set to true, the instance is stopped with force when it does not shut down within shutdown_timeout, otherwise the delete fails",
+						},
+						isInstancePreDestroyFinalSnapshot: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "If set to true, a snapshot of the boot volume is created after the instance is stopped. The snapshot is not deleted with the instance",
+						},
+						isInstancePreDestroyFinalSnapshotName: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance", isInstancePreDestroyFinalSnapshotName),
+							Description:  "The name of the final snapshot, by default the instance name followed by -final- and a timestamp",
+						},
+					},
+				},
+			},
+
 			isInstanceAction: {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -535,10 +593,20 @@ func ResourceIBMISInstance() *schema.Resource {
 			},
 
 			isInstanceUserData: {
-				Type:        schema.TypeString,
-				ForceNew:    true,
-				Optional:    true,
-				Description: "User data given for the instance",
+				Type:          schema.TypeString,
+				ForceNew:      true,
+				Optional:      true,
+				ConflictsWith: []string{isInstanceUserDataPreset},
+				Description:   "User data given for the instance",
+			},
+
+			isInstanceUserDataPreset: {
+				Type:          schema.TypeString,
+				ForceNew:      true,
+				Optional:      true,
+				ConflictsWith: []string{isInstanceUserData},
+				ValidateFunc:  validate.ValidateAllowedStringValues([]string{isInstanceUserDataPresetNvidiaGpu}),
+				Description:   "A cloud-init preset used as user data of the instance, nvidia_gpu_driver installs the NVIDIA GPU driver on Ubuntu images of GPU profiles",
 			},
 
 			isInstanceImage: {
@@ -904,6 +972,16 @@ func ResourceIBMISInstanceValidator() *validate.ResourceValidator {
 			Optional:                   true,
 			AllowedValues:              host_failure})
 
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isInstancePreDestroyFinalSnapshotName,
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63})
+
 	ibmISInstanceValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance", Schema: validateSchema}
 	return &ibmISInstanceValidator
 }
@@ -1203,8 +1281,7 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 		instanceproto.Keys = keyobjs
 	}
 
-	if userdata, ok := d.GetOk(isInstanceUserData); ok {
-		userdatastr := userdata.(string)
+	if userdatastr, ok := instanceUserData(d); ok {
 		instanceproto.UserData = &userdatastr
 	}
 
@@ -1232,18 +1309,18 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
 		return err
 	}
-	d.SetId(*instance.ID)
+	flex.TrackCreatedID(d, *instance.ID)
 
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
 	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
-		return err
+		return flex.TrackedCreateError(d, "instance", err)
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	v := flex.EnvTags(meta)
+	if _, ok := d.GetOk(isInstanceTags); ok || len(v) > 0 {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -1556,8 +1633,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 		instanceproto.Keys = keyobjs
 	}
 
-	if userdata, ok := d.GetOk(isInstanceUserData); ok {
-		userdatastr := userdata.(string)
+	if userdatastr, ok := instanceUserData(d); ok {
 		instanceproto.UserData = &userdatastr
 	}
 
@@ -1585,7 +1661,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
 		return err
 	}
-	d.SetId(*instance.ID)
+	flex.TrackCreatedID(d, *instance.ID)
 
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
@@ -1596,11 +1672,11 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 
 	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
-		return err
+		return flex.TrackedCreateError(d, "instance", err)
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	v := flex.EnvTags(meta)
+	if _, ok := d.GetOk(isInstanceTags); ok || len(v) > 0 {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -1904,8 +1980,7 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 		instanceproto.Keys = keyobjs
 	}
 
-	if userdata, ok := d.GetOk(isInstanceUserData); ok {
-		userdatastr := userdata.(string)
+	if userdatastr, ok := instanceUserData(d); ok {
 		instanceproto.UserData = &userdatastr
 	}
 
@@ -1938,18 +2013,18 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
 		return err
 	}
-	d.SetId(*instance.ID)
+	flex.TrackCreatedID(d, *instance.ID)
 
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
 	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
-		return err
+		return flex.TrackedCreateError(d, "instance", err)
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	v := flex.EnvTags(meta)
+	if _, ok := d.GetOk(isInstanceTags); ok || len(v) > 0 {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -2983,7 +3058,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getinsOptions := &vpcv1.GetInstanceOptions{
 		ID: &id,
 	}
-	_, response, err := instanceC.GetInstance(getinsOptions)
+	instance, response, err := instanceC.GetInstance(getinsOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -2994,7 +3069,16 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 
 	bootvolid := ""
 
-	if cleanDelete {
+	stopped := false
+	if _, ok := d.GetOk(isInstancePreDestroy); ok {
+		err = instancePreDestroy(instanceC, d, instance)
+		if err != nil {
+			return err
+		}
+		stopped = true
+	}
+
+	if cleanDelete && !stopped {
 		actiontype := "stop"
 		createinsactoptions := &vpcv1.CreateInstanceActionOptions{
 			InstanceID: &id,
@@ -3011,6 +3095,8 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if err != nil {
 			return err
 		}
+	}
+	if cleanDelete {
 		listvolattoptions := &vpcv1.ListInstanceVolumeAttachmentsOptions{
 			InstanceID: &id,
 		}
@@ -3060,6 +3146,94 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
+// instancePreDestroy runs the pre_destroy actions of the instance: it stops the
+// instance gracefully, with force after shutdown_timeout if force_stop is set,
+// and then takes the final snapshot of the boot volume.
+func instancePreDestroy(instanceC *vpcv1.VpcV1, d *schema.ResourceData, instance *vpcv1.Instance) error {
+	id := *instance.ID
+	preDestroy := d.Get(isInstancePreDestroy).([]interface{})[0].(map[string]interface{})
+	shutdownTimeout := time.Duration(preDestroy[isInstancePreDestroyShutdownTimeout].(int)) * time.Minute
+
+	if *instance.Status != isInstanceActionStatusStopped {
+		actiontype := "stop"
+		createinsactoptions := &vpcv1.CreateInstanceActionOptions{
+			InstanceID: &id,
+			Type:       &actiontype,
+			Force:      core.BoolPtr(false),
+		}
+		_, response, err := instanceC.CreateInstanceAction(createinsactoptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+		}
+		_, err = isWaitForInstanceActionStop(instanceC, shutdownTimeout, id, d)
+		if err != nil {
+			if _, ok := err.(*resource.TimeoutError); !ok || !preDestroy[isInstancePreDestroyForceStop].(bool) {
+				return fmt.Errorf("[ERROR] Error waiting for instance (%s) to shut down: %s", id, err)
+			}
+			log.Printf("[INFO] Instance (%s) did not shut down within %s, stopping it with force", id, shutdownTimeout)
+			createinsactoptions.Force = core.BoolPtr(true)
+			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+			}
+			_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutDelete), id, d)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if preDestroy[isInstancePreDestroyFinalSnapshot].(bool) && instance.BootVolumeAttachment != nil && instance.BootVolumeAttachment.Volume != nil {
+		name := preDestroy[isInstancePreDestroyFinalSnapshotName].(string)
+		if name == "" {
+			name = instanceFinalSnapshotName(*instance.Name, "-final")
+		} else {
+			// The final snapshot of a previous instance with the same
+			// final_snapshot_name is kept, a replaced instance cannot reuse the name
+			snapshots, response, err := instanceC.ListSnapshots(&vpcv1.ListSnapshotsOptions{Name: &name})
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error listing snapshots named %s: %s\n%s", name, err, response)
+			}
+			if len(snapshots.Snapshots) > 0 {
+				taken := name
+				name = instanceFinalSnapshotName(taken, "")
+				log.Printf("[INFO] A snapshot named %s already exists, the final snapshot of instance (%s) is named %s", taken, id, name)
+			}
+		}
+		options := &vpcv1.CreateSnapshotOptions{
+			Name: &name,
+			SourceVolume: &vpcv1.VolumeIdentity{
+				ID: instance.BootVolumeAttachment.Volume.ID,
+			},
+		}
+		if instance.ResourceGroup != nil {
+			options.ResourceGroup = &vpcv1.ResourceGroupIdentity{
+				ID: instance.ResourceGroup.ID,
+			}
+		}
+		snapshot, response, err := instanceC.CreateSnapshot(options)
+		if err != nil || snapshot == nil {
+			return fmt.Errorf("[ERROR] Error creating final snapshot of instance (%s) boot volume: %s\n%s", id, err, response)
+		}
+		log.Printf("[INFO] Final snapshot of instance (%s) boot volume : %s", id, *snapshot.ID)
+		_, err = isWaitForSnapshotAvailable(instanceC, *snapshot.ID, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// instanceFinalSnapshotName returns name followed by infix and a timestamp,
+// within the 63 characters of a snapshot name.
+func instanceFinalSnapshotName(name, infix string) string {
+	suffix := fmt.Sprintf("%s-%d", infix, time.Now().Unix())
+	if len(name)+len(suffix) > 63 {
+		name = strings.TrimRight(name[:63-len(suffix)], "-")
+	}
+	return name + suffix
+}
+
 func resourceIBMisInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 
 	id := d.Id()
@@ -3342,3 +3516,59 @@ func resourceIbmIsInstanceDedicatedHostGroupReferenceDeletedToMap(dedicatedHostG
 
 	return dedicatedHostGroupReferenceDeletedMap
 }
+
+// instanceUserData returns the user data of the instance, either given as is
+// or from a preset.
+func instanceUserData(d *schema.ResourceData) (string, bool) {
+	if userdata, ok := d.GetOk(isInstanceUserData); ok {
+		return userdata.(string), true
+	}
+	if preset, ok := d.GetOk(isInstanceUserDataPreset); ok && preset.(string) == isInstanceUserDataPresetNvidiaGpu {
+		return isInstanceUserDataNvidiaGpuDrivers, true
+	}
+	return "", false
+}
+
+// isInstanceProfileCache caches the instance profiles looked up at plan time for
+// the run of the provider, keyed by the VPC endpoint and the profile name.
+var isInstanceProfileCache sync.Map
+
+// resourceIBMISInstanceProfileCustomizeDiff checks at plan time that the
+// profile exists in the region, and that the NVIDIA GPU driver preset is only
+// used with a GPU profile. The profile is only looked up when it or the preset
+// changes, and once per profile.
+func resourceIBMISInstanceProfileCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange(isInstanceProfile) && !diff.HasChange(isInstanceUserDataPreset) {
+		return nil
+	}
+	profile := diff.Get(isInstanceProfile).(string)
+	if profile == "" || !diff.NewValueKnown(isInstanceProfile) {
+		return nil
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	key := sess.Service.GetServiceURL() + "/" + profile
+	var instanceProfile *vpcv1.InstanceProfile
+	if cached, ok := isInstanceProfileCache.Load(key); ok {
+		instanceProfile = cached.(*vpcv1.InstanceProfile)
+	} else {
+		getInstanceProfileOptions := &vpcv1.GetInstanceProfileOptions{
+			Name: &profile,
+		}
+		var response *core.DetailedResponse
+		instanceProfile, response, err = sess.GetInstanceProfile(getInstanceProfileOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return fmt.Errorf("[ERROR] Instance profile %s is not available in this region, run `ibmcloud is instance-profiles` to list the available profiles", profile)
+			}
+			return fmt.Errorf("[ERROR] Error getting instance profile %s: %s\n%s", profile, err, response)
+		}
+		isInstanceProfileCache.Store(key, instanceProfile)
+	}
+	if diff.Get(isInstanceUserDataPreset).(string) == isInstanceUserDataPresetNvidiaGpu && instanceProfile.GpuCount == nil {
+		return fmt.Errorf("[ERROR] %s %s can only be used with a GPU instance profile, %s has no GPUs", isInstanceUserDataPreset, isInstanceUserDataPresetNvidiaGpu, profile)
+	}
+	return nil
+}
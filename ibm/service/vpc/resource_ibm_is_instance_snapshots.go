@@ -0,0 +1,351 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isInstanceSnapshotsInstance          = "instance"
+	isInstanceSnapshotsNamePrefix        = "name_prefix"
+	isInstanceSnapshotsIncludeBootVolume = "include_boot_volume"
+	isInstanceSnapshotsSnapshots         = "snapshots"
+	isInstanceSnapshotsVolumeAttachment  = "volume_attachment"
+)
+
+func ResourceIBMISInstanceSnapshots() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMISInstanceSnapshotsCreate,
+		Read:     resourceIBMISInstanceSnapshotsRead,
+		Delete:   resourceIBMISInstanceSnapshotsDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isInstanceSnapshotsInstance: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The instance whose attached volumes are snapshotted",
+			},
+
+			isInstanceSnapshotsNamePrefix: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_instance_snapshots", isInstanceSnapshotsNamePrefix),
+				Description:  "Prefix of the snapshot names, <prefix>-boot for the boot volume and <prefix>-data-<n> for the data volumes",
+			},
+
+			isInstanceSnapshotsIncludeBootVolume: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Whether the boot volume is snapshotted along with the data volumes",
+			},
+
+			isSnapshotResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Resource group of the snapshots",
+			},
+
+			isInstanceSnapshotsSnapshots: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The snapshots of the volumes, the boot volume first",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the snapshot",
+						},
+						isSnapshotName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Snapshot name",
+						},
+						isSnapshotCRN: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The crn of the snapshot",
+						},
+						isSnapshotSourceVolume: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Snapshot source volume",
+						},
+						isInstanceSnapshotsVolumeAttachment: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the volume attachment of the source volume",
+						},
+						isSnapshotBootable: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates if a boot volume attachment can be created with a volume created from this snapshot",
+						},
+						isSnapshotLCState: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Snapshot lifecycle state",
+						},
+						isSnapshotSize: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The size of the snapshot",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMISInstanceSnapshotsValidator() *validate.ResourceValidator {
+
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isInstanceSnapshotsNamePrefix,
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             50})
+	ibmISInstanceSnapshotsResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance_snapshots", Schema: validateSchema}
+	return &ibmISInstanceSnapshotsResourceValidator
+}
+
+func resourceIBMISInstanceSnapshotsCreate(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	instanceID := d.Get(isInstanceSnapshotsInstance).(string)
+
+	listInstanceVolumeAttOptions := &vpcv1.ListInstanceVolumeAttachmentsOptions{
+		InstanceID: &instanceID,
+	}
+	volumeAtts, response, err := sess.ListInstanceVolumeAttachments(listInstanceVolumeAttOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Fetching Instance volume attachments %s\n%s", err, response)
+	}
+
+	includeBoot := d.Get(isInstanceSnapshotsIncludeBootVolume).(bool)
+	attachments := []vpcv1.VolumeAttachment{}
+	for _, volumeAtt := range volumeAtts.VolumeAttachments {
+		if volumeAtt.Volume == nil || (*volumeAtt.Type == "boot" && !includeBoot) {
+			continue
+		}
+		attachments = append(attachments, volumeAtt)
+	}
+	if len(attachments) == 0 {
+		return fmt.Errorf("[ERROR] Instance %s has no volume to snapshot", instanceID)
+	}
+	sort.SliceStable(attachments, func(i, j int) bool {
+		iBoot := *attachments[i].Type == "boot"
+		jBoot := *attachments[j].Type == "boot"
+		if iBoot != jBoot {
+			return iBoot
+		}
+		return *attachments[i].Name < *attachments[j].Name
+	})
+
+	// Create all the snapshots before waiting for any of them, so that they
+	// are captured as close together as possible.
+	snapshotIDs := make([]string, 0, len(attachments))
+	dataIndex := 0
+	for _, volumeAtt := range attachments {
+		options := &vpcv1.CreateSnapshotOptions{
+			SourceVolume: &vpcv1.VolumeIdentity{
+				ID: volumeAtt.Volume.ID,
+			},
+		}
+		if prefix, ok := d.GetOk(isInstanceSnapshotsNamePrefix); ok {
+			name := fmt.Sprintf("%s-boot", prefix.(string))
+			if *volumeAtt.Type != "boot" {
+				dataIndex++
+				name = fmt.Sprintf("%s-data-%d", prefix.(string), dataIndex)
+			}
+			options.Name = &name
+		}
+		if grp, ok := d.GetOk(isSnapshotResourceGroup); ok {
+			rg := grp.(string)
+			options.ResourceGroup = &vpcv1.ResourceGroupIdentity{
+				ID: &rg,
+			}
+		}
+		snapshot, response, err := sess.CreateSnapshot(options)
+		if err != nil || snapshot == nil {
+			if len(snapshotIDs) > 0 {
+				d.SetId(makeInstanceSnapshotsID(instanceID, snapshotIDs))
+			}
+			return fmt.Errorf("[ERROR] Error creating Snapshot of volume %s %s\n%s", *volumeAtt.Volume.ID, err, response)
+		}
+		log.Printf("[INFO] Snapshot : %s", *snapshot.ID)
+		snapshotIDs = append(snapshotIDs, *snapshot.ID)
+	}
+	d.SetId(makeInstanceSnapshotsID(instanceID, snapshotIDs))
+
+	for _, id := range snapshotIDs {
+		_, err = isWaitForSnapshotAvailable(sess, id, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMISInstanceSnapshotsRead(d, meta)
+}
+
+func resourceIBMISInstanceSnapshotsRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	instanceID, snapshotIDs, err := parseInstanceSnapshotsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	attachmentNames := map[string]string{}
+	bootVolumeID := ""
+	listInstanceVolumeAttOptions := &vpcv1.ListInstanceVolumeAttachmentsOptions{
+		InstanceID: &instanceID,
+	}
+	volumeAtts, response, err := sess.ListInstanceVolumeAttachments(listInstanceVolumeAttOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return fmt.Errorf("[ERROR] Error Fetching Instance volume attachments %s\n%s", err, response)
+	}
+	if err == nil {
+		for _, volumeAtt := range volumeAtts.VolumeAttachments {
+			if volumeAtt.Volume != nil {
+				attachmentNames[*volumeAtt.Volume.ID] = *volumeAtt.Name
+				if *volumeAtt.Type == "boot" {
+					bootVolumeID = *volumeAtt.Volume.ID
+				}
+			}
+		}
+	}
+
+	snapshots := make([]map[string]interface{}, 0, len(snapshotIDs))
+	resourceGroup := ""
+	includeBoot := false
+	for _, id := range snapshotIDs {
+		getSnapshotOptions := &vpcv1.GetSnapshotOptions{
+			ID: &id,
+		}
+		snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			return fmt.Errorf("[ERROR] Error getting Snapshot : %s\n%s", err, response)
+		}
+		s := map[string]interface{}{
+			"id":               *snapshot.ID,
+			isSnapshotName:     *snapshot.Name,
+			isSnapshotCRN:      *snapshot.CRN,
+			isSnapshotBootable: *snapshot.Bootable,
+			isSnapshotLCState:  *snapshot.LifecycleState,
+			isSnapshotSize:     *snapshot.Size,
+		}
+		if snapshot.SourceVolume != nil && snapshot.SourceVolume.ID != nil {
+			s[isSnapshotSourceVolume] = *snapshot.SourceVolume.ID
+			s[isInstanceSnapshotsVolumeAttachment] = attachmentNames[*snapshot.SourceVolume.ID]
+		}
+		// Without the volume attachments of the instance, a bootable snapshot
+		// is taken as the snapshot of its boot volume
+		if bootVolumeID != "" {
+			if snapshot.SourceVolume != nil && snapshot.SourceVolume.ID != nil && *snapshot.SourceVolume.ID == bootVolumeID {
+				includeBoot = true
+			}
+		} else if *snapshot.Bootable {
+			includeBoot = true
+		}
+		if snapshot.ResourceGroup != nil && snapshot.ResourceGroup.ID != nil {
+			resourceGroup = *snapshot.ResourceGroup.ID
+		}
+		snapshots = append(snapshots, s)
+	}
+	if len(snapshots) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set(isInstanceSnapshotsInstance, instanceID)
+	d.Set(isInstanceSnapshotsIncludeBootVolume, includeBoot)
+	if resourceGroup != "" {
+		d.Set(isSnapshotResourceGroup, resourceGroup)
+	}
+	if err = d.Set(isInstanceSnapshotsSnapshots, snapshots); err != nil {
+		return fmt.Errorf("[ERROR] Error setting snapshots %s", err)
+	}
+	return nil
+}
+
+func resourceIBMISInstanceSnapshotsDelete(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	_, snapshotIDs, err := parseInstanceSnapshotsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	deleted := []string{}
+	for _, id := range snapshotIDs {
+		deleteSnapshotOptions := &vpcv1.DeleteSnapshotOptions{
+			ID: &id,
+		}
+		response, err := sess.DeleteSnapshot(deleteSnapshotOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			return fmt.Errorf("[ERROR] Error deleting Snapshot : %s\n%s", err, response)
+		}
+		deleted = append(deleted, id)
+	}
+	for _, id := range deleted {
+		_, err = isWaitForSnapshotDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return err
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// makeInstanceSnapshotsID returns <instance ID>/<snapshot ID>,<snapshot ID>...
+func makeInstanceSnapshotsID(instanceID string, snapshotIDs []string) string {
+	return fmt.Sprintf("%s/%s", instanceID, strings.Join(snapshotIDs, ","))
+}
+
+func parseInstanceSnapshotsID(id string) (string, []string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", nil, fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of instanceID/snapshotID,snapshotID", id)
+	}
+	return parts[0], strings.Split(parts[1], ","), nil
+}
@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISInstanceSnapshots_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instnace-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+	volname := fmt.Sprintf("tf-vol-%d", acctest.RandIntRange(10, 100))
+	prefix := fmt.Sprintf("tfsnapshots-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISInstanceSnapshotsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceSnapshotsConfig(vpcname, subnetname, sshname, publicKey, volname, name, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_snapshots.testacc_snapshots", "snapshots.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_snapshots.testacc_snapshots", "snapshots.0.name", prefix+"-boot"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_snapshots.testacc_snapshots", "snapshots.0.bootable", "true"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_snapshots.testacc_snapshots", "snapshots.1.name", prefix+"-data-1"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_instance_snapshots.testacc_snapshots", "snapshots.1.source_volume",
+						"ibm_is_volume.testacc_volume", "id"),
+				),
+			},
+			{
+				ResourceName:            "ibm_is_instance_snapshots.testacc_snapshots",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name_prefix"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISInstanceSnapshotsDestroy(s *terraform.State) error {
+	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_instance_snapshots" {
+			continue
+		}
+		parts := strings.Split(rs.Primary.ID, "/")
+		for _, id := range strings.Split(parts[len(parts)-1], ",") {
+			getSnapshotOptions := &vpcv1.GetSnapshotOptions{
+				ID: &id,
+			}
+			_, _, err := sess.GetSnapshot(getSnapshotOptions)
+			if err == nil {
+				return fmt.Errorf("Snapshot still exists: %s", id)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISInstanceSnapshotsConfig(vpcname, subnetname, sshname, publicKey, volname, name, prefix string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	  }
+
+	  resource "ibm_is_subnet" "testacc_subnet" {
+		name           				= "%s"
+		vpc             			= ibm_is_vpc.testacc_vpc.id
+		zone            			= "%s"
+		total_ipv4_address_count 	= 16
+	  }
+
+	  resource "ibm_is_ssh_key" "testacc_sshkey" {
+		name       = "%s"
+		public_key = "%s"
+	  }
+
+	  resource "ibm_is_volume" "testacc_volume" {
+		name    = "%s"
+		profile = "10iops-tier"
+		zone    = "%s"
+	  }
+
+	  resource "ibm_is_instance" "testacc_instance" {
+		name    = "%s"
+		image   = "%s"
+		profile = "%s"
+		primary_network_interface {
+		  subnet     = ibm_is_subnet.testacc_subnet.id
+		}
+		vpc     = ibm_is_vpc.testacc_vpc.id
+		zone    = "%s"
+		keys    = [ibm_is_ssh_key.testacc_sshkey.id]
+		volumes = [ibm_is_volume.testacc_volume.id]
+	  }
+
+	  resource "ibm_is_instance_snapshots" "testacc_snapshots" {
+		instance    = ibm_is_instance.testacc_instance.id
+		name_prefix = "%s"
+	  }`, vpcname, subnetname, acc.ISZoneName, sshname, publicKey, volname, acc.ISZoneName, name, acc.IsImage, acc.InstanceProfileName, acc.ISZoneName, prefix)
+}
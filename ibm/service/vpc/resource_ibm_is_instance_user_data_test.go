@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	gohttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+type instanceProfileSession struct {
+	conns.ClientSession
+	vpcAPI *vpcv1.VpcV1
+}
+
+func (s instanceProfileSession) VpcV1API() (*vpcv1.VpcV1, error) {
+	return s.vpcAPI, nil
+}
+
+func (s instanceProfileSession) DefaultTags() []string {
+	return nil
+}
+
+func TestInstanceUserData(t *testing.T) {
+	cases := []struct {
+		name     string
+		raw      map[string]interface{}
+		want     string
+		wantSent bool
+	}{
+		{"none", map[string]interface{}{}, "", false},
+		{"user data", map[string]interface{}{isInstanceUserData: "#cloud-config"}, "#cloud-config", true},
+		{"preset", map[string]interface{}{isInstanceUserDataPreset: isInstanceUserDataPresetNvidiaGpu}, isInstanceUserDataNvidiaGpuDrivers, true},
+	}
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, ResourceIBMISInstance().Schema, c.raw)
+		got, sent := instanceUserData(d)
+		if got != c.want || sent != c.wantSent {
+			t.Errorf("%s: got %q, %t, want %q, %t", c.name, got, sent, c.want, c.wantSent)
+		}
+	}
+}
+
+func TestResourceIBMISInstanceProfileCustomizeDiff(t *testing.T) {
+	lookups := map[string]int{}
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		name := strings.TrimPrefix(r.URL.Path, "/instance/profiles/")
+		lookups[name]++
+		w.Header().Set("Content-Type", "application/json")
+		switch name {
+		case "gx2-8x64x1v100":
+			fmt.Fprint(w, `{"name": "gx2-8x64x1v100", "gpu_count": {"type": "fixed", "value": 1}}`)
+		case "cx2-2x4":
+			fmt.Fprint(w, `{"name": "cx2-2x4"}`)
+		default:
+			w.WriteHeader(gohttp.StatusNotFound)
+			fmt.Fprint(w, `{"errors": [{"code": "not_found", "message": "Instance profile not found"}]}`)
+		}
+	}))
+	defer server.Close()
+	vpcAPI, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	meta := instanceProfileSession{vpcAPI: vpcAPI}
+
+	cases := []struct {
+		name    string
+		raw     map[string]interface{}
+		wantErr string
+	}{
+		{"profile", map[string]interface{}{isInstanceProfile: "cx2-2x4"}, ""},
+		{"unknown profile", map[string]interface{}{isInstanceProfile: "zz1-2x4"}, "is not available in this region"},
+		{"preset with gpu profile", map[string]interface{}{isInstanceProfile: "gx2-8x64x1v100", isInstanceUserDataPreset: isInstanceUserDataPresetNvidiaGpu}, ""},
+		{"preset without gpu profile", map[string]interface{}{isInstanceProfile: "cx2-2x4", isInstanceUserDataPreset: isInstanceUserDataPresetNvidiaGpu}, "can only be used with a GPU instance profile"},
+	}
+	for _, c := range cases {
+		_, err := ResourceIBMISInstance().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(c.raw), meta)
+		switch {
+		case c.wantErr == "" && err != nil:
+			t.Errorf("%s: unexpected error %s", c.name, err)
+		case c.wantErr != "" && (err == nil || !strings.Contains(err.Error(), c.wantErr)):
+			t.Errorf("%s: got error %v, want %q", c.name, err, c.wantErr)
+		}
+	}
+	if lookups["cx2-2x4"] != 1 {
+		t.Errorf("profile cx2-2x4 was looked up %d times, want 1", lookups["cx2-2x4"])
+	}
+}
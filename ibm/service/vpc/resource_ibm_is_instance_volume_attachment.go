@@ -322,7 +322,7 @@ func instanceVolAttachmentCreate(d *schema.ResourceData, meta interface{}, insta
 		instanceVolAttproto.Volume = volProtoVol
 	}
 
-	if autoDelete, ok := d.GetOk(isInstanceVolumeDeleteOnInstanceDelete); ok {
+	if autoDelete, ok := d.GetOkExists(isInstanceVolumeDeleteOnInstanceDelete); ok {
 		autoDeleteBool := autoDelete.(bool)
 		instanceVolAttproto.DeleteVolumeOnInstanceDelete = &autoDeleteBool
 	}
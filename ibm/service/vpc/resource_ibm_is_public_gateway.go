@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -51,8 +50,9 @@ func ResourceIBMISPublicGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
+			resourceIBMISPublicGatewayCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -173,6 +173,72 @@ func ResourceIBMISPublicGatewayValidator() *validate.ResourceValidator {
 	return &ibmISPublicGatewayResourceValidator
 }
 
+// resourceIBMISPublicGatewayCustomizeDiff checks at plan time that a new public
+// gateway is the only one in its zone of the VPC, and that an existing floating
+// IP to reuse is in the same zone and not bound to another target.
+func resourceIBMISPublicGatewayCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChange(isPublicGatewayVPC) && !diff.HasChange(isPublicGatewayZone) {
+		return nil
+	}
+	vpc := diff.Get(isPublicGatewayVPC).(string)
+	zone := diff.Get(isPublicGatewayZone).(string)
+	if vpc == "" || zone == "" || !diff.NewValueKnown(isPublicGatewayVPC) || !diff.NewValueKnown(isPublicGatewayZone) {
+		return nil
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+
+	start := ""
+	for {
+		listPublicGatewaysOptions := &vpcv1.ListPublicGatewaysOptions{}
+		if start != "" {
+			listPublicGatewaysOptions.Start = &start
+		}
+		publicgws, response, err := sess.ListPublicGateways(listPublicGatewaysOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Fetching public gateways %s\n%s", err, response)
+		}
+		for _, publicgw := range publicgws.PublicGateways {
+			if *publicgw.VPC.ID == vpc && *publicgw.Zone.Name == zone && *publicgw.ID != diff.Id() {
+				return fmt.Errorf("[ERROR] VPC %s already has the public gateway %s (%s) in zone %s, a VPC can only have one public gateway per zone", vpc, *publicgw.Name, *publicgw.ID, zone)
+			}
+		}
+		start = flex.GetNext(publicgws.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	if !diff.NewValueKnown(isPublicGatewayFloatingIP) {
+		return nil
+	}
+	floatingipdata, _ := diff.Get(isPublicGatewayFloatingIP).(map[string]interface{})
+	floatingipID, _ := floatingipdata["id"].(string)
+	if floatingipID == "" {
+		return nil
+	}
+	getFloatingIPOptions := &vpcv1.GetFloatingIPOptions{
+		ID: &floatingipID,
+	}
+	fip, response, err := sess.GetFloatingIP(getFloatingIPOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting floating IP %s for the public gateway: %s\n%s", floatingipID, err, response)
+	}
+	if fip.Zone != nil && *fip.Zone.Name != zone {
+		return fmt.Errorf("[ERROR] Floating IP %s is in zone %s, it cannot be used by a public gateway in zone %s", floatingipID, *fip.Zone.Name, zone)
+	}
+	if target, ok := fip.Target.(*vpcv1.FloatingIPTarget); ok && target.ID != nil && *target.ID == diff.Id() {
+		// The floating IP is kept by the public gateway that is replaced
+		return nil
+	}
+	if fip.Target != nil {
+		return fmt.Errorf("[ERROR] Floating IP %s is bound to another target, unbind it before it is used by the public gateway", floatingipID)
+	}
+	return nil
+}
+
 func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{}) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
@@ -226,8 +292,8 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isPublicGatewayTags); ok || v != "" {
+	v := flex.EnvTags(meta)
+	if _, ok := d.GetOk(isPublicGatewayTags); ok || len(v) > 0 {
 		oldList, newList := d.GetChange(isPublicGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *publicgw.CRN)
 		if err != nil {
@@ -6,6 +6,7 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -57,6 +58,39 @@ func TestAccIBMISPublicGateway_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMISPublicGateway_floatingIPAndZoneValidation(t *testing.T) {
+	var publicgw string
+	vpcname := fmt.Sprintf("tfpgw-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-create-name-%d", acctest.RandIntRange(10, 100))
+	fipname := fmt.Sprintf("tfpgw-fip-%d", acctest.RandIntRange(10, 100))
+	zone := "us-south-1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISPublicGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISPublicGatewayFloatingIPConfig(vpcname, name, fipname, zone),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISPublicGatewayExists("ibm_is_public_gateway.testacc_public_gateway", publicgw),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_public_gateway.testacc_public_gateway", "floating_ip.id", "ibm_is_floating_ip.testacc_fip", "id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISPublicGatewayFloatingIPConfig(vpcname, name, fipname, zone) + fmt.Sprintf(`
+resource "ibm_is_public_gateway" "testacc_public_gateway_duplicate" {
+	name = "%s-duplicate"
+	vpc  = ibm_is_vpc.testacc_vpc.id
+	zone = "%s"
+}`, name, zone),
+				ExpectError: regexp.MustCompile("only have one public gateway per zone"),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISPublicGatewayDestroy(s *terraform.State) error {
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 	for _, rs := range s.RootModule().Resources {
@@ -115,3 +149,24 @@ resource "ibm_is_public_gateway" "testacc_public_gateway" {
 }`, vpcname, name, zone)
 
 }
+
+func testAccCheckIBMISPublicGatewayFloatingIPConfig(vpcname, name, fipname, zone string) string {
+	return fmt.Sprintf(`
+resource "ibm_is_vpc" "testacc_vpc" {
+	name = "%s"
+}
+
+resource "ibm_is_floating_ip" "testacc_fip" {
+	name = "%s"
+	zone = "%s"
+}
+
+resource "ibm_is_public_gateway" "testacc_public_gateway" {
+	name = "%s"
+	vpc  = ibm_is_vpc.testacc_vpc.id
+	zone = "%s"
+	floating_ip = {
+		id = ibm_is_floating_ip.testacc_fip.id
+	}
+}`, vpcname, fipname, zone, name, zone)
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"reflect"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -38,7 +37,7 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -172,8 +171,8 @@ func resourceIBMISSecurityGroupCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("[ERROR] Error while creating Security Group %s\n%s", err, response)
 	}
 	d.SetId(*sg.ID)
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isSecurityGroupTags); ok || v != "" {
+	v := flex.EnvTags(meta)
+	if _, ok := d.GetOk(isSecurityGroupTags); ok || len(v) > 0 {
 		oldList, newList := d.GetChange(isSecurityGroupTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *sg.CRN)
 		if err != nil {
@@ -30,6 +30,7 @@ const (
 	isSecurityGroupRuleType             = "type"
 	isSecurityGroupID                   = "group"
 	isSecurityGroupRuleID               = "rule_id"
+	isSecurityGroupRuleDescription      = "description"
 )
 
 func ResourceIBMISSecurityGroupRule() *schema.Resource {
@@ -57,6 +58,12 @@ func ResourceIBMISSecurityGroupRule() *schema.Resource {
 				Description: "Rule id",
 			},
 
+			isSecurityGroupRuleDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Human readable intent of the rule, kept in the state only as the VPC API does not store rule descriptions",
+			},
+
 			isSecurityGroupRuleDirection: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -405,6 +412,11 @@ func resourceIBMISSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceIBMISSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	// The description is only kept in the state, nothing to send to the API
+	if !d.HasChangeExcept(isSecurityGroupRuleDescription) {
+		return resourceIBMISSecurityGroupRuleRead(d, meta)
+	}
+
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
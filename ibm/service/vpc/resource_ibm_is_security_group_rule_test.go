@@ -42,6 +42,37 @@ func TestAccIBMISSecurityGroupRule_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccIBMISSecurityGroupRule_description(t *testing.T) {
+	var securityGroupRule string
+
+	vpcname := fmt.Sprintf("tfsgrule-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfsgrule-createname-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISSecurityGroupRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISsecurityGroupRuleDescriptionConfig(vpcname, name, "allow-all-from-localhost"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupRuleExists("ibm_is_security_group_rule.testacc_security_group_rule_all", securityGroupRule),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_rule.testacc_security_group_rule_all", "description", "allow-all-from-localhost"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISsecurityGroupRuleDescriptionConfig(vpcname, name, "allow-all-from-loopback"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupRuleExists("ibm_is_security_group_rule.testacc_security_group_rule_all", securityGroupRule),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_rule.testacc_security_group_rule_all", "description", "allow-all-from-loopback"),
+				),
+			},
+		},
+	})
+}
 func parseISTerraformID(s string) (string, string, error) {
 	segments := strings.Split(s, ".")
 	if len(segments) != 2 {
@@ -121,6 +152,26 @@ func testAccCheckIBMISSecurityGroupRuleExists(n, securityGroupRuleID string) res
 	}
 }
 
+func testAccCheckIBMISsecurityGroupRuleDescriptionConfig(vpcname, name, description string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	  }
+	  
+	  resource "ibm_is_security_group" "testacc_security_group" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+	  }
+	  
+	  resource "ibm_is_security_group_rule" "testacc_security_group_rule_all" {
+		group       = ibm_is_security_group.testacc_security_group.id
+		direction   = "inbound"
+		remote      = "127.0.0.1"
+		description = "%s"
+	  }
+	`, vpcname, name, description)
+}
+
 func testAccCheckIBMISsecurityGroupRuleConfig(vpcname, name string) string {
 	return fmt.Sprintf(`
 	resource "ibm_is_vpc" "testacc_vpc" {
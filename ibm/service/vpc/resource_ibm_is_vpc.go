@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"reflect"
 	"time"
 
@@ -76,7 +75,7 @@ func ResourceIBMISVPC() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -497,8 +496,8 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVPCTags); ok || v != "" {
+	v := flex.EnvTags(meta)
+	if _, ok := d.GetOk(isVPCTags); ok || len(v) > 0 {
 		oldList, newList := d.GetChange(isVPCTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *vpc.CRN)
 		if err != nil {
@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceIBMISVPCDefaultRoutingTable adopts the default routing table that is
+// created together with a VPC, so that it can be renamed and configured in the
+// same apply that creates the VPC. Deleting the resource only removes it from
+// the state; the default routing table is deleted with the VPC.
+func ResourceIBMISVPCDefaultRoutingTable() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMISVPCDefaultRoutingTableCreate,
+		Read:     resourceIBMISVPCRoutingTableRead,
+		Update:   resourceIBMISVPCRoutingTableUpdate,
+		Delete:   resourceIBMISVPCDefaultRoutingTableDelete,
+		Exists:   resourceIBMISVPCRoutingTableExists,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: resourceIBMISVPCDefaultRoutingTableSchema(),
+	}
+}
+
+func resourceIBMISVPCDefaultRoutingTableSchema() map[string]*schema.Schema {
+	rtSchema := ResourceIBMISVPCRoutingTable().Schema
+	rtSchema[rtVpcID].Description = "The VPC whose default routing table is managed."
+	// Keep the current settings of the default routing table unless they are configured.
+	for _, key := range []string{rtRouteDirectLinkIngress, rtRouteTransitGatewayIngress, rtRouteVPCZoneIngress} {
+		rtSchema[key].Default = nil
+		rtSchema[key].Computed = true
+	}
+	return rtSchema
+}
+
+func resourceIBMISVPCDefaultRoutingTableCreate(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+
+	vpcID := d.Get(rtVpcID).(string)
+	getVPCDefaultRoutingTableOptions := sess.NewGetVPCDefaultRoutingTableOptions(vpcID)
+	routeTable, response, err := sess.GetVPCDefaultRoutingTable(getVPCDefaultRoutingTableOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Getting default VPC Routing table of VPC %s: %s\n%s", vpcID, err, response)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", vpcID, *routeTable.ID))
+
+	routingTablePatchModel := new(vpcv1.RoutingTablePatch)
+	hasChange := false
+	if name, ok := d.GetOk(rtName); ok && name.(string) != *routeTable.Name {
+		routingTablePatchModel.Name = core.StringPtr(name.(string))
+		hasChange = true
+	}
+	if v, ok := d.GetOkExists(rtRouteDirectLinkIngress); ok {
+		routingTablePatchModel.RouteDirectLinkIngress = core.BoolPtr(v.(bool))
+		hasChange = true
+	}
+	if v, ok := d.GetOkExists(rtRouteTransitGatewayIngress); ok {
+		routingTablePatchModel.RouteTransitGatewayIngress = core.BoolPtr(v.(bool))
+		hasChange = true
+	}
+	if v, ok := d.GetOkExists(rtRouteVPCZoneIngress); ok {
+		routingTablePatchModel.RouteVPCZoneIngress = core.BoolPtr(v.(bool))
+		hasChange = true
+	}
+	if hasChange {
+		routingTablePatchModelAsPatch, asPatchErr := routingTablePatchModel.AsPatch()
+		if asPatchErr != nil {
+			return fmt.Errorf("[ERROR] Error calling asPatch for RoutingTablePatchModel: %s", asPatchErr)
+		}
+		updateVpcRoutingTableOptions := sess.NewUpdateVPCRoutingTableOptions(vpcID, *routeTable.ID, routingTablePatchModelAsPatch)
+		_, response, err := sess.UpdateVPCRoutingTable(updateVpcRoutingTableOptions)
+		if err != nil {
+			log.Printf("[DEBUG] Update default VPC Routing table err %s\n%s", err, response)
+			return err
+		}
+	}
+
+	return resourceIBMISVPCRoutingTableRead(d, meta)
+}
+
+func resourceIBMISVPCDefaultRoutingTableDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Removing default VPC Routing table %s from state, it is deleted together with its VPC", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISVPCDefaultRoutingTable_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tfrt-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfrt-default-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tfrt-default-up-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCDefaultRoutingTableConfig(vpcname, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_default_routing_table.default", "name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_default_routing_table.default", "is_default", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPCDefaultRoutingTableConfig(vpcname, nameUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_default_routing_table.default", "name", nameUpdate),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPCDefaultRoutingTableConfig(vpcname, name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_vpc_default_routing_table" "default" {
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		name = "%s"
+	}`, vpcname, name)
+}
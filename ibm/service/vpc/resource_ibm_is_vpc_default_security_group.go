@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isDefaultSecurityGroupDeleteDefaultRules = "delete_default_rules"
+)
+
+// ResourceIBMISVPCDefaultSecurityGroup adopts the default security group that
+// is created together with a VPC, so that it can be renamed, tagged and
+// emptied in the same apply that creates the VPC. Deleting the resource only
+// removes it from the state; the default security group is deleted with the VPC.
+func ResourceIBMISVPCDefaultSecurityGroup() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMISVPCDefaultSecurityGroupCreate,
+		Read:     resourceIBMISSecurityGroupRead,
+		Update:   resourceIBMISSecurityGroupUpdate,
+		Delete:   resourceIBMISVPCDefaultSecurityGroupDelete,
+		Importer: &schema.ResourceImporter{},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return flex.ResourceTagsCustomizeDiff(diff, v)
+			},
+		),
+
+		Schema: resourceIBMISVPCDefaultSecurityGroupSchema(),
+	}
+}
+
+func resourceIBMISVPCDefaultSecurityGroupSchema() map[string]*schema.Schema {
+	sgSchema := ResourceIBMISSecurityGroup().Schema
+	sgSchema[isSecurityGroupVPC].Description = "The VPC whose default security group is managed"
+	sgSchema[isSecurityGroupResourceGroup] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Resource Group ID",
+	}
+	sgSchema[isDefaultSecurityGroupDeleteDefaultRules] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+		Description: "If set to true, the rules of the default security group are deleted when it is adopted",
+	}
+	return sgSchema
+}
+
+func resourceIBMISVPCDefaultSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	vpcID := d.Get(isSecurityGroupVPC).(string)
+
+	getVPCDefaultSecurityGroupOptions := sess.NewGetVPCDefaultSecurityGroupOptions(vpcID)
+	sg, response, err := sess.GetVPCDefaultSecurityGroup(getVPCDefaultSecurityGroupOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting default Security Group of VPC %s: %s\n%s", vpcID, err, response)
+	}
+	d.SetId(*sg.ID)
+	d.Set(isSecurityGroupCRN, *sg.CRN)
+
+	if d.Get(isDefaultSecurityGroupDeleteDefaultRules).(bool) {
+		for _, rule := range sg.Rules {
+			ruleID := securityGroupRuleID(rule)
+			if ruleID == "" {
+				continue
+			}
+			deleteSecurityGroupRuleOptions := sess.NewDeleteSecurityGroupRuleOptions(*sg.ID, ruleID)
+			response, err := sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
+			if err != nil && (response == nil || response.StatusCode != 404) {
+				return fmt.Errorf("[ERROR] Error deleting rule %s of default Security Group %s: %s\n%s", ruleID, *sg.ID, err, response)
+			}
+		}
+	}
+
+	return resourceIBMISSecurityGroupUpdate(d, meta)
+}
+
+func resourceIBMISVPCDefaultSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Removing default Security Group %s from state, it is deleted together with its VPC", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func securityGroupRuleID(rule vpcv1.SecurityGroupRuleIntf) string {
+	var id *string
+	switch r := rule.(type) {
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll:
+		id = r.ID
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp:
+		id = r.ID
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp:
+		id = r.ID
+	case *vpcv1.SecurityGroupRule:
+		id = r.ID
+	}
+	if id == nil {
+		return ""
+	}
+	return *id
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISVPCDefaultSecurityGroup_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tfsg-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfsg-default-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tfsg-default-up-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCDefaultSecurityGroupConfig(vpcname, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_default_security_group.default", "name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_default_security_group.default", "rules.#", "0"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_vpc_default_security_group.default", "id", "ibm_is_vpc.testacc_vpc", "default_security_group"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPCDefaultSecurityGroupConfig(vpcname, nameUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_default_security_group.default", "name", nameUpdate),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPCDefaultSecurityGroupConfig(vpcname, name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_vpc_default_security_group" "default" {
+		vpc                  = ibm_is_vpc.testacc_vpc.id
+		name                 = "%s"
+		delete_default_rules = true
+	}`, vpcname, name)
+}